@@ -0,0 +1,414 @@
+package computer
+
+// AddressingMode describes how an instruction locates its operand.
+type AddressingMode uint8
+
+const (
+	// Implied the operand is implied by the instruction (e.g. CLC)
+	Implied AddressingMode = iota
+	// Accumulator the instruction operates on the accumulator (e.g. ASL A)
+	Accumulator
+	// Immediate the operand is the byte following the opcode (e.g. LDA #$10)
+	Immediate
+	// ZeroPage the operand is located in the zero page (e.g. LDA $10)
+	ZeroPage
+	// ZeroPageX the zero page address is indexed by the X-Register (e.g. LDA $10,X)
+	ZeroPageX
+	// ZeroPageY the zero page address is indexed by the Y-Register (e.g. LDX $10,Y)
+	ZeroPageY
+	// Absolute the operand is located at a full 16 bit address (e.g. LDA $1234)
+	Absolute
+	// AbsoluteX the absolute address is indexed by the X-Register (e.g. LDA $1234,X)
+	AbsoluteX
+	// AbsoluteY the absolute address is indexed by the Y-Register (e.g. LDA $1234,Y)
+	AbsoluteY
+	// Indirect the operand is the address stored at the given address (only JMP ($1234))
+	Indirect
+	// IndexedIndirect the zero page address is indexed by X and then dereferenced (e.g. LDA ($10,X))
+	IndexedIndirect
+	// IndirectIndexed the zero page address is dereferenced and then indexed by Y (e.g. LDA ($10),Y)
+	IndirectIndexed
+	// Relative a signed offset relative to the next instruction (branches only)
+	Relative
+	// ZeroPageIndirect the zero page address is dereferenced without index (65C02 only, e.g. LDA ($10))
+	ZeroPageIndirect
+	// AbsoluteIndexedIndirect the absolute address is indexed by X and then dereferenced (65C02 only, JMP ($1234,X))
+	AbsoluteIndexedIndirect
+	// ZeroPageRelative a zero page address followed by a branch offset (65C02 only, BBR and BBS)
+	ZeroPageRelative
+
+	// The modes of the 65816. On the 65816 the zero page modes address the direct page, see SixFiveEightSixteen.DirectPage.
+
+	// StackRelative the offset is added to the StackPointer (e.g. LDA $03,S)
+	StackRelative
+	// StackRelativeIndirectY the pointer at the StackPointer plus the offset is indexed by Y (e.g. LDA ($03,S),Y)
+	StackRelativeIndirectY
+	// ZeroPageIndirectLong the direct page location holds a 24 bit address (e.g. LDA [$10])
+	ZeroPageIndirectLong
+	// ZeroPageIndirectLongY the 24 bit address at the direct page location is indexed by Y (e.g. LDA [$10],Y)
+	ZeroPageIndirectLongY
+	// AbsoluteLong the operand is located at a full 24 bit address (e.g. LDA $123456)
+	AbsoluteLong
+	// AbsoluteLongX the 24 bit address is indexed by the X-Register (e.g. LDA $123456,X)
+	AbsoluteLongX
+	// AbsoluteIndirectLong the 24 bit address is stored at the given address in bank 0 (only JML [$1234])
+	AbsoluteIndirectLong
+	// RelativeLong a signed 16 bit offset relative to the next instruction (BRL and PER)
+	RelativeLong
+	// BlockMove the destination bank followed by the source bank (MVN and MVP)
+	BlockMove
+)
+
+var addressingModeNames = [...]string{
+	Implied:         "Implied",
+	Accumulator:     "Accumulator",
+	Immediate:       "Immediate",
+	ZeroPage:        "ZeroPage",
+	ZeroPageX:       "ZeroPageX",
+	ZeroPageY:       "ZeroPageY",
+	Absolute:        "Absolute",
+	AbsoluteX:       "AbsoluteX",
+	AbsoluteY:       "AbsoluteY",
+	Indirect:        "Indirect",
+	IndexedIndirect: "IndexedIndirect",
+	IndirectIndexed: "IndirectIndexed",
+	Relative:        "Relative",
+
+	ZeroPageIndirect:        "ZeroPageIndirect",
+	AbsoluteIndexedIndirect: "AbsoluteIndexedIndirect",
+	ZeroPageRelative:        "ZeroPageRelative",
+
+	StackRelative:          "StackRelative",
+	StackRelativeIndirectY: "StackRelativeIndirectY",
+	ZeroPageIndirectLong:   "ZeroPageIndirectLong",
+	ZeroPageIndirectLongY:  "ZeroPageIndirectLongY",
+	AbsoluteLong:           "AbsoluteLong",
+	AbsoluteLongX:          "AbsoluteLongX",
+	AbsoluteIndirectLong:   "AbsoluteIndirectLong",
+	RelativeLong:           "RelativeLong",
+	BlockMove:              "BlockMove",
+}
+
+func (m AddressingMode) String() string {
+	if int(m) < len(addressingModeNames) {
+		return addressingModeNames[m]
+	}
+	return "AddressingMode(" + Word(m).String() + ")"
+}
+
+// operand is the result of resolving an addressing mode.
+type operand struct {
+	mode AddressingMode
+	// address is the effective address of the operand.
+	// For Immediate it points at the byte following the opcode, for Relative it is the branch target.
+	address Address
+	// target is the branch target of ZeroPageRelative, address is the zero page location to test then.
+	target Address
+	// bank is the bank of the address on the 65816, see operandMemory. The zero page modes address bank 0.
+	bank Word
+	// pageCrossed is set when indexing (or a branch) crossed a page boundary.
+	// Whether this costs an extra cycle is decided by the indexTiming table.
+	pageCrossed bool
+}
+
+// resolve decodes the operand of the current instruction according to the addressing mode.
+// The ProgramCounter has to point at the byte following the opcode and is advanced past the
+// operand bytes. Only the cycles needed to calculate the effective address are spent here,
+// the operand itself is read (or written) by the instruction.
+func (cpu *SixFiveOTwo) resolve(mem Memory, mode AddressingMode) operand {
+	op := operand{mode: mode}
+	switch mode {
+	case Implied, Accumulator:
+		// The 6502 reads the byte after the opcode and throws it away
+		cpu.programRead(mem, cpu.ProgramCounter)
+	case Immediate:
+		op.address, op.bank = cpu.ProgramCounter, cpu.programBank()
+		cpu.ProgramCounter++
+	case ZeroPage:
+		op.address = cpu.zeroPageAddress(Address(cpu.fetchZeroPage(mem)))
+	case ZeroPageX:
+		op.address = cpu.zeroPageIndexed(mem, cpu.RegisterX)
+	case ZeroPageY:
+		op.address = cpu.zeroPageIndexed(mem, cpu.RegisterY)
+	case Absolute:
+		op.address = cpu.FetchAddress(mem)
+		op.bank = cpu.dataBank(op.address, op.address)
+	case AbsoluteX:
+		base := cpu.FetchAddress(mem)
+		op.address, op.pageCrossed = indexed(base, cpu.RegisterX)
+		op.bank = cpu.dataBank(base, op.address)
+	case AbsoluteY:
+		base := cpu.FetchAddress(mem)
+		op.address, op.pageCrossed = indexed(base, cpu.RegisterY)
+		op.bank = cpu.dataBank(base, op.address)
+	case Indirect:
+		pointer := cpu.FetchAddress(mem)
+		if cpu.Model.cmos() {
+			// The CMOS models fixed the page bug below. The 65C02 spends an extra cycle on it, it reads the last operand byte again
+			if cpu.Model.c02Timing() {
+				cpu.programRead(mem, cpu.ProgramCounter-1)
+			}
+			op.address = cpu.readPointer(mem, pointer, pointer+1)
+			break
+		}
+		// The NMOS 6502 does not carry into the high byte of the pointer,
+		// JMP ($10FF) reads the low byte from $10FF and the high byte from $1000.
+		op.address = cpu.readPointer(mem, pointer, pointer&0xFF00|(pointer+1)&0x00FF)
+	case IndexedIndirect:
+		base := Address(cpu.fetchZeroPage(mem))
+		// The 6502 reads the unindexed zero page address while adding X
+		cpu.dummyRead(mem, cpu.zeroPageAddress(base))
+		pointer := base + Address(cpu.RegisterX)
+		op.address = cpu.readPointer(mem, cpu.zeroPageAddress(pointer), cpu.zeroPageAddress(pointer+1))
+		op.bank = cpu.dataBank(op.address, op.address)
+	case IndirectIndexed:
+		pointer := Address(cpu.fetchZeroPage(mem))
+		base := cpu.readPointer(mem, cpu.zeroPageAddress(pointer), cpu.zeroPageAddress(pointer+1))
+		op.address, op.pageCrossed = indexed(base, cpu.RegisterY)
+		op.bank = cpu.dataBank(base, op.address)
+	case Relative:
+		offset := cpu.FetchWordFromProgramCounter(mem)
+		op.address = cpu.ProgramCounter + Address(int8(offset))
+		op.pageCrossed = op.address&0xFF00 != cpu.ProgramCounter&0xFF00
+	case ZeroPageIndirect:
+		pointer := Address(cpu.fetchZeroPage(mem))
+		op.address = cpu.readPointer(mem, cpu.zeroPageAddress(pointer), cpu.zeroPageAddress(pointer+1))
+		op.bank = cpu.dataBank(op.address, op.address)
+	case AbsoluteIndexedIndirect:
+		base := cpu.FetchAddress(mem)
+		// The 65C02 adds X to the pointer in an extra cycle, it reads the last operand byte again
+		cpu.programRead(mem, cpu.ProgramCounter-1)
+		pointer := base + Address(cpu.RegisterX)
+		// The pointer is part of the program, on the 65816 it is located in the ProgramBank
+		op.address = cpu.readPointer(cpu.programMemory(mem), pointer, pointer+1)
+	case ZeroPageRelative:
+		op.address = cpu.zeroPageAddress(Address(cpu.fetchZeroPage(mem)))
+		offset := cpu.FetchWordFromProgramCounter(mem)
+		op.target = cpu.ProgramCounter + Address(int8(offset))
+		op.pageCrossed = op.target&0xFF00 != cpu.ProgramCounter&0xFF00
+	}
+	cpu.logger.LogE("%s %s\n", mode, op.address)
+	return op
+}
+
+// zeroPageIndexed fetches a zero page address and adds the index to it.
+// The result wraps around inside the zero page, this never costs an extra cycle.
+func (cpu *SixFiveOTwo) zeroPageIndexed(mem Memory, index Word) Address {
+	base := Address(cpu.fetchZeroPage(mem))
+	// The 6502 reads the unindexed zero page address while adding the index
+	cpu.dummyRead(mem, cpu.zeroPageAddress(base))
+	return cpu.zeroPageAddress(base + Address(index))
+}
+
+// fetchZeroPage fetches the offset of a zero page mode. The 65816 spends an extra cycle on adding a DirectPage
+// that is not page aligned, it reads the ProgramCounter meanwhile.
+func (cpu *SixFiveOTwo) fetchZeroPage(mem Memory) Word {
+	offset := cpu.FetchWordFromProgramCounter(mem)
+	if cpu.unalignedDirectPage() {
+		cpu.programRead(mem, cpu.ProgramCounter)
+	}
+	return offset
+}
+
+// zeroPageAddress returns the address of a zero page offset that may include an index. The 6502 wraps it around
+// inside the zero page. The 65816 adds its DirectPage in bank 0, it only wraps around inside the page if the
+// DirectPage is page aligned.
+func (cpu *SixFiveOTwo) zeroPageAddress(offset Address) Address {
+	if cpu.banks == nil {
+		return offset & 0x00FF
+	}
+	if cpu.unalignedDirectPage() {
+		return cpu.banks.direct + offset
+	}
+	return cpu.banks.direct | offset&0x00FF
+}
+
+// unalignedDirectPage reports whether the 65816 runs the core with a DirectPage that is not page aligned.
+func (cpu *SixFiveOTwo) unalignedDirectPage() bool {
+	return cpu.banks != nil && cpu.banks.direct&0x00FF != 0
+}
+
+// programBank returns the bank of the ProgramCounter, the ProgramBank of the 65816 and 0 on the 6502.
+func (cpu *SixFiveOTwo) programBank() Word {
+	if cpu.banks == nil {
+		return 0
+	}
+	return cpu.banks.program
+}
+
+// dataBank returns the bank of an absolute or indirect address, the DataBank of the 65816 and 0 on the 6502.
+// Indexing that wrapped the address below its base carries into the next bank.
+func (cpu *SixFiveOTwo) dataBank(base, address Address) Word {
+	if cpu.banks == nil {
+		return 0
+	}
+	if address < base {
+		return cpu.banks.data + 1
+	}
+	return cpu.banks.data
+}
+
+// programMemory returns the Memory the ProgramCounter addresses, on the 65816 it is the ProgramBank.
+func (cpu *SixFiveOTwo) programMemory(mem Memory) Memory {
+	if cpu.banks == nil {
+		return mem
+	}
+	cpu.banks.programPins = memoryBank{mem: cpu.banks.mem, bank: cpu.banks.program}
+	return &cpu.banks.programPins
+}
+
+// operandMemory returns the Memory the address of a resolved operand is located in, on the 65816 it is its bank.
+func (cpu *SixFiveOTwo) operandMemory(mem Memory, op operand) Memory {
+	if cpu.banks == nil {
+		return mem
+	}
+	cpu.banks.operandPins = memoryBank{mem: cpu.banks.mem, bank: op.bank}
+	return &cpu.banks.operandPins
+}
+
+// indexed adds the index to the base address and reports whether a page boundary was crossed.
+func indexed(base Address, index Word) (Address, bool) {
+	address := base + Address(index)
+	return address, address&0xFF00 != base&0xFF00
+}
+
+// readPointer reads a little endian address. The two locations are passed separately because
+// the zero page modes wrap around inside the zero page.
+func (cpu *SixFiveOTwo) readPointer(mem Memory, lsbAddress, msbAddress Address) Address {
+	lsb := cpu.FetchWord(mem, lsbAddress)
+	msb := cpu.FetchWord(mem, msbAddress)
+	return Address(msb)<<8 | Address(lsb)
+}
+
+// access is the way an instruction uses its operand
+type access uint8
+
+const (
+	readAccess access = iota
+	writeAccess
+	modifyAccess
+	// incrementAccess is the read-modify-write of INC and DEC, the 65C02 times it differently than the other ones
+	incrementAccess
+)
+
+// penalty is the rule for the extra cycle an indexed addressing mode can cost
+type penalty uint8
+
+const (
+	// noPenalty the index never costs an extra cycle
+	noPenalty penalty = iota
+	// pageCrossPenalty the index costs one cycle if adding it crossed a page
+	pageCrossPenalty
+	// alwaysPenalty the index always costs one cycle
+	alwaysPenalty
+)
+
+// indexTiming is the timing table of the indexed addressing modes, indexed by the access of the instruction.
+//
+// The 6502 adds the index to the low byte of the address and accesses the result before the carry
+// into the high byte is known. Reads repeat the access with the corrected address in an extra cycle if
+// a page was crossed. Writes and read-modify-writes can not take back a write to the wrong page,
+// so they always wait for the corrected address.
+// Zero page indexing wraps around inside the zero page and never pays a penalty, the cycle spent on
+// adding the index is part of the addressing mode (see zeroPageIndexed).
+//
+// tests/Timing_test.go checks the resulting cycle counts against the published ones.
+var indexTiming = [...][4]penalty{
+	ZeroPageX:       {readAccess: noPenalty, writeAccess: noPenalty, modifyAccess: noPenalty, incrementAccess: noPenalty},
+	ZeroPageY:       {readAccess: noPenalty, writeAccess: noPenalty, modifyAccess: noPenalty, incrementAccess: noPenalty},
+	AbsoluteX:       {readAccess: pageCrossPenalty, writeAccess: alwaysPenalty, modifyAccess: alwaysPenalty, incrementAccess: alwaysPenalty},
+	AbsoluteY:       {readAccess: pageCrossPenalty, writeAccess: alwaysPenalty, modifyAccess: alwaysPenalty, incrementAccess: alwaysPenalty},
+	IndirectIndexed: {readAccess: pageCrossPenalty, writeAccess: alwaysPenalty, modifyAccess: alwaysPenalty, incrementAccess: alwaysPenalty},
+}
+
+// cmosIndexTiming is the indexTiming table of the 65C02.
+// The shifts and rotates with AbsoluteX addressing only pay for a page cross, INC and DEC still always pay.
+var cmosIndexTiming = [...][4]penalty{
+	ZeroPageX:       {readAccess: noPenalty, writeAccess: noPenalty, modifyAccess: noPenalty, incrementAccess: noPenalty},
+	ZeroPageY:       {readAccess: noPenalty, writeAccess: noPenalty, modifyAccess: noPenalty, incrementAccess: noPenalty},
+	AbsoluteX:       {readAccess: pageCrossPenalty, writeAccess: alwaysPenalty, modifyAccess: pageCrossPenalty, incrementAccess: alwaysPenalty},
+	AbsoluteY:       {readAccess: pageCrossPenalty, writeAccess: alwaysPenalty, modifyAccess: alwaysPenalty, incrementAccess: alwaysPenalty},
+	IndirectIndexed: {readAccess: pageCrossPenalty, writeAccess: alwaysPenalty, modifyAccess: alwaysPenalty, incrementAccess: alwaysPenalty},
+}
+
+// indexPenalty spends the extra cycle the indexTiming table demands for the operand and access.
+//
+// The NMOS 6502 reads the address before the carry into the high byte was added, the 65C02 reads the
+// last operand byte again instead.
+func (cpu *SixFiveOTwo) indexPenalty(mem Memory, op operand, kind access) {
+	switch cpu.penaltyOf(op, kind) {
+	case pageCrossPenalty:
+		if !op.pageCrossed {
+			return
+		}
+		cpu.logger.LogE("Page crossed\n")
+	case alwaysPenalty:
+	default:
+		return
+	}
+	if cpu.Model.c02Timing() {
+		cpu.programRead(mem, cpu.ProgramCounter-1)
+		return
+	}
+	uncorrected := op
+	if op.pageCrossed {
+		uncorrected.address -= 0x0100
+		if uncorrected.address > op.address {
+			uncorrected.bank--
+		}
+	}
+	cpu.dummyRead(cpu.operandMemory(mem, uncorrected), uncorrected.address)
+}
+
+// penaltyOf returns the rule of the indexTiming table of the model for the operand and access.
+func (cpu *SixFiveOTwo) penaltyOf(op operand, kind access) penalty {
+	timing := indexTiming[:]
+	if cpu.Model.c02Timing() {
+		timing = cmosIndexTiming[:]
+	}
+	if int(op.mode) >= len(timing) {
+		return noPenalty
+	}
+	return timing[op.mode][kind]
+}
+
+// readOperand returns the value of a resolved operand.
+func (cpu *SixFiveOTwo) readOperand(mem Memory, op operand) Word {
+	if op.mode == Accumulator {
+		return cpu.Accumulator
+	}
+	cpu.indexPenalty(mem, op, readAccess)
+	return cpu.FetchWord(cpu.operandMemory(mem, op), op.address)
+}
+
+// writeOperand stores value at the address of a resolved operand.
+func (cpu *SixFiveOTwo) writeOperand(mem Memory, op operand, value Word) {
+	cpu.indexPenalty(mem, op, writeAccess)
+	cpu.StoreWord(cpu.operandMemory(mem, op), op.address, value)
+}
+
+// modifyOperand performs a read-modify-write on a resolved operand and returns the new value.
+// The NMOS 6502 writes the unmodified value back while it is modifying it, so memory sees two writes.
+// The 65C02 reads the value a second time instead.
+func (cpu *SixFiveOTwo) modifyOperand(mem Memory, op operand, modify func(Word) Word) Word {
+	return cpu.readModifyWrite(mem, op, modifyAccess, modify)
+}
+
+// readModifyWrite implements modifyOperand for the given access, see indexTiming.
+func (cpu *SixFiveOTwo) readModifyWrite(mem Memory, op operand, kind access, modify func(Word) Word) Word {
+	if op.mode == Accumulator {
+		cpu.Accumulator = modify(cpu.Accumulator)
+		return cpu.Accumulator
+	}
+	cpu.indexPenalty(mem, op, kind)
+	operandMem := cpu.operandMemory(mem, op)
+	value := cpu.FetchWord(operandMem, op.address)
+	if cpu.Model.c02Timing() {
+		cpu.dummyRead(operandMem, op.address)
+	} else {
+		cpu.StoreWord(operandMem, op.address, value)
+	}
+	value = modify(value)
+	cpu.StoreWord(operandMem, op.address, value)
+	return value
+}
@@ -0,0 +1,134 @@
+package computer
+
+// adc ADC - Add with Carry
+//
+// This instruction adds the contents of a memory location to the accumulator together with the carry bit.
+// If overflow occurs the carry bit is set, this enables multiple byte addition to be performed.
+func (cpu *SixFiveOTwo) adc(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op)
+	oldAcc := cpu.Accumulator
+	if cpu.decimalMode() {
+		cpu.addDecimal(value)
+		cpu.decimalCycle(mem, op)
+	} else {
+		cpu.addBinary(value)
+	}
+	cpu.logger.LogE("A(%s) + M(%s) = A(%s)\n", oldAcc, value, cpu.Accumulator)
+}
+
+// sbc SBC - Subtract with Carry
+//
+// This instruction subtracts the contents of a memory location to the accumulator together with the not of the carry bit.
+// If overflow occurs the carry bit is clear, this enables multiple byte subtraction to be performed.
+func (cpu *SixFiveOTwo) sbc(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op)
+	oldAcc := cpu.Accumulator
+	if cpu.decimalMode() {
+		cpu.subtractDecimal(value)
+		cpu.decimalCycle(mem, op)
+	} else {
+		// A - M - (1 - C) is the same as A + ^M + C in two's complement
+		cpu.addBinary(^value)
+	}
+	cpu.logger.LogE("A(%s) - M(%s) = A(%s)\n", oldAcc, value, cpu.Accumulator)
+}
+
+// decimalMode reports whether ADC and SBC work on packed BCD values.
+// The decimal flag is ignored by models without decimal mode, see Ricoh2A03.
+func (cpu *SixFiveOTwo) decimalMode() bool {
+	return cpu.Status.GetDecimalFlag() == 1 && cpu.Model.hasDecimalMode()
+}
+
+// addBinary adds value and the carry to the accumulator and sets the N, V, Z and C flags.
+func (cpu *SixFiveOTwo) addBinary(value Word) {
+	sum := uint16(cpu.Accumulator) + uint16(value) + uint16(cpu.Status.GetCarryFlag())
+	result := Word(sum)
+
+	cpu.Status.SetCarryFlag(sum > 0xFF)
+	cpu.Status.SetOverflowFlag(overflows(cpu.Accumulator, value, result))
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, result)
+}
+
+// addDecimal adds value and the carry to the accumulator treating both as packed BCD.
+//
+// The flags follow the NMOS 6502: Z is taken from the binary sum, N and V are taken from the
+// intermediate result after the low nibble was adjusted but before the high nibble was adjusted.
+// Only C is valid in the BCD sense. The 65C02 sets valid N and Z flags, see fixDecimalFlags.
+func (cpu *SixFiveOTwo) addDecimal(value Word) {
+	a := uint16(cpu.Accumulator)
+	m := uint16(value)
+	carry := uint16(cpu.Status.GetCarryFlag())
+
+	lo := a&0x0F + m&0x0F + carry
+	if lo >= 0x0A {
+		lo = (lo+0x06)&0x0F + 0x10
+	}
+	sum := a&0xF0 + m&0xF0 + lo
+
+	cpu.Status.SetZeroFlag(Word(a+m+carry) == 0)
+	cpu.Status.SetNegativeFlag(Word(sum)&bit7 != 0)
+	cpu.Status.SetOverflowFlag(overflows(cpu.Accumulator, value, Word(sum)))
+
+	if sum >= 0xA0 {
+		sum += 0x60
+	}
+	cpu.Status.SetCarryFlag(sum >= 0x100)
+	cpu.Accumulator = Word(sum)
+	cpu.fixDecimalFlags()
+}
+
+// subtractDecimal subtracts value and the borrow (not carry) from the accumulator treating both as packed BCD.
+//
+// On the NMOS 6502 all flags are taken from the binary subtraction, only the accumulator is adjusted.
+// The 65C02 adjusts the result differently for invalid BCD values and sets valid N and Z flags, see fixDecimalFlags.
+func (cpu *SixFiveOTwo) subtractDecimal(value Word) {
+	a := int(cpu.Accumulator)
+	m := int(value)
+	borrow := 1 - int(cpu.Status.GetCarryFlag())
+
+	lo := a&0x0F - m&0x0F - borrow
+	var diff int
+	if cpu.Model.cmos() {
+		diff = a - m - borrow
+		if diff < 0 {
+			diff -= 0x60
+		}
+		if lo < 0 {
+			diff -= 0x06
+		}
+	} else {
+		if lo < 0 {
+			lo = (lo-0x06)&0x0F - 0x10
+		}
+		diff = a&0xF0 - m&0xF0 + lo
+		if diff < 0 {
+			diff -= 0x60
+		}
+	}
+
+	cpu.addBinary(^value)
+	cpu.Accumulator = Word(diff)
+	cpu.fixDecimalFlags()
+}
+
+// fixDecimalFlags sets the N and Z flags from the accumulator after a decimal mode addition or subtraction
+// on the 65C02, which needs an extra cycle for it (see decimalCycle). The NMOS 6502 keeps its invalid flags.
+func (cpu *SixFiveOTwo) fixDecimalFlags() {
+	if !cpu.Model.cmos() {
+		return
+	}
+	cpu.evaluateAndSetStatusFlags(cpu.Accumulator)
+}
+
+// decimalCycle spends the extra cycle of a decimal mode ADC or SBC on the 65C02, it reads the operand again.
+func (cpu *SixFiveOTwo) decimalCycle(mem Memory, op operand) {
+	if cpu.Model.c02Timing() {
+		cpu.dummyRead(cpu.operandMemory(mem, op), op.address)
+	}
+}
+
+// overflows reports whether adding two signed bytes with the given result overflowed,
+// i.e. both operands have the same sign and the sign of the result differs.
+func overflows(lhs, rhs, result Word) bool {
+	return (lhs^result)&(rhs^result)&bit7 != 0
+}
@@ -0,0 +1,44 @@
+package computer
+
+// The Rockwell bit instructions of the 65C02. The bit number is part of the opcode,
+// the opcode table passes it to the handler.
+
+// rmb RMB - Reset Memory Bit
+//
+// Clears a bit of a zero page location. No flags are affected.
+func (cpu *SixFiveOTwo) rmb(mem Memory, op operand, bit uint8) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		return value &^ (1 << bit)
+	})
+}
+
+// smb SMB - Set Memory Bit
+//
+// Sets a bit of a zero page location. No flags are affected.
+func (cpu *SixFiveOTwo) smb(mem Memory, op operand, bit uint8) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		return value | 1<<bit
+	})
+}
+
+// bbr BBR - Branch on Bit Reset
+//
+// Branches if a bit of a zero page location is clear. No flags are affected.
+func (cpu *SixFiveOTwo) bbr(mem Memory, op operand, bit uint8) {
+	cpu.branch(mem, operand{mode: Relative, address: op.target, pageCrossed: op.pageCrossed}, cpu.testBit(mem, op, bit) == 0)
+}
+
+// bbs BBS - Branch on Bit Set
+//
+// Branches if a bit of a zero page location is set. No flags are affected.
+func (cpu *SixFiveOTwo) bbs(mem Memory, op operand, bit uint8) {
+	cpu.branch(mem, operand{mode: Relative, address: op.target, pageCrossed: op.pageCrossed}, cpu.testBit(mem, op, bit) != 0)
+}
+
+// testBit reads the zero page location of a ZeroPageRelative operand and returns the bit.
+func (cpu *SixFiveOTwo) testBit(mem Memory, op operand, bit uint8) Word {
+	value := cpu.readOperand(mem, op)
+	// The 65C02 spends an extra cycle testing the bit, it reads the location again
+	cpu.dummyRead(cpu.operandMemory(mem, op), op.address)
+	return value & (1 << bit)
+}
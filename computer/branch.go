@@ -0,0 +1,66 @@
+package computer
+
+// branch jumps to the target of a Relative operand if the condition holds.
+//
+// A branch takes 2 cycles if it is not taken, 3 if it is taken and 4 if the
+// target is on a different page than the instruction following the branch.
+//
+// The taken branch reads the opcode following the branch while it adds the offset, crossing a page
+// reads the target before the carry into the high byte was added.
+func (cpu *SixFiveOTwo) branch(mem Memory, op operand, condition bool) {
+	if !condition {
+		return
+	}
+	cpu.programRead(mem, cpu.ProgramCounter)
+	if op.pageCrossed {
+		cpu.logger.LogE("Page crossed\n")
+		cpu.programRead(mem, cpu.ProgramCounter&0xFF00|op.address&0x00FF)
+	}
+	cpu.ProgramCounter = op.address
+	cpu.logger.LogE("Branch taken %s\n", cpu.ProgramCounter)
+}
+
+// bcc BCC - Branch if Carry Clear
+func (cpu *SixFiveOTwo) bcc(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetCarryFlag() == 0)
+}
+
+// bcs BCS - Branch if Carry Set
+func (cpu *SixFiveOTwo) bcs(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetCarryFlag() == 1)
+}
+
+// beq BEQ - Branch if Equal
+func (cpu *SixFiveOTwo) beq(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetZeroFlag() == 1)
+}
+
+// bne BNE - Branch if Not Equal
+func (cpu *SixFiveOTwo) bne(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetZeroFlag() == 0)
+}
+
+// bmi BMI - Branch if Minus
+func (cpu *SixFiveOTwo) bmi(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetNegativeFlag() == 1)
+}
+
+// bpl BPL - Branch if Positive
+func (cpu *SixFiveOTwo) bpl(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetNegativeFlag() == 0)
+}
+
+// bvc BVC - Branch if Overflow Clear
+func (cpu *SixFiveOTwo) bvc(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetOverflowFlag() == 0)
+}
+
+// bvs BVS - Branch if Overflow Set
+func (cpu *SixFiveOTwo) bvs(mem Memory, op operand) {
+	cpu.branch(mem, op, cpu.Status.GetOverflowFlag() == 1)
+}
+
+// bra BRA - Branch Always (65C02 only)
+func (cpu *SixFiveOTwo) bra(mem Memory, op operand) {
+	cpu.branch(mem, op, true)
+}
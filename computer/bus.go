@@ -0,0 +1,137 @@
+package computer
+
+// Bus sits between the CPU and its Memory. Every cycle of the 6502 is exactly one access to the bus,
+// the cycles the CPU is busy internally are dummy reads (see dummyRead). The Bus performs all reads and
+// writes and is the only place that counts a cycle, so Cycle is always the number of accesses and the
+// opcode table can be checked against it (see SixFiveOTwo.VerifyCycles).
+//
+// The Bus also calls the watches (see Watch) and suspends the CPU between two accesses while it is
+// ticked (see SixFiveOTwo.Tick).
+type Bus struct {
+	// Cycle is the number of accesses to the bus since PowerOn
+	Cycle uint
+
+	// watches are called for the accesses to the bus, see Watch
+	watches []*watch
+	// instructionAddress is the address of the opcode of the current instruction
+	instructionAddress Address
+
+	// ticker runs the instructions for Tick, yield suspends it between two cycles (see waitForTick)
+	ticker *ticker
+	yield  func(error) bool
+
+	// logger is told the cycle of every access
+	logger CpuLogger
+}
+
+// busMemory is the part of a Memory the Bus accesses.
+type busMemory interface {
+	ReadWord(source Address) Word
+	WriteWord(destination Address, value Word)
+}
+
+// read reads a Word from the Memory in one cycle, the kind tells the watches an opcode fetch from other reads.
+func (b *Bus) read(mem busMemory, address Address, kind WatchKind) Word {
+	b.waitForTick()
+	data := mem.ReadWord(address)
+	b.addCycle()
+	if len(b.watches) != 0 {
+		b.notifyWatches(kind, address, data)
+	}
+	return data
+}
+
+// write writes a Word to the Memory in one cycle.
+func (b *Bus) write(mem busMemory, address Address, value Word) {
+	b.waitForTick()
+	mem.WriteWord(address, value)
+	b.addCycle()
+	if len(b.watches) != 0 {
+		b.notifyWatches(WatchWrite, address, value)
+	}
+}
+
+// addCycle counts the cycle of an access, only read and write call it.
+func (b *Bus) addCycle() {
+	b.Cycle = b.Cycle + 1
+	b.logger.SetCycle(b.Cycle)
+}
+
+// FetchWord fetches a Word from Memory at the specified address, it takes one cycle.
+func (cpu *SixFiveOTwo) FetchWord(mem Memory, address Address) Word {
+	return cpu.busRead(mem, address, WatchRead)
+}
+
+// StoreWord writes a Word to Memory at the specified address, it takes one cycle.
+func (cpu *SixFiveOTwo) StoreWord(mem Memory, address Address, value Word) {
+	cpu.Bus.write(cpu.pins(mem), address&cpu.Model.addressMask(), value)
+}
+
+// busRead implements FetchWord, the kind tells the watches an opcode fetch from other reads.
+func (cpu *SixFiveOTwo) busRead(mem Memory, address Address, kind WatchKind) Word {
+	return cpu.Bus.read(cpu.pins(mem), address&cpu.Model.addressMask(), kind)
+}
+
+// Peek returns the value at the address like a debugger sees it, see Memory.Peek. Unlike Memory.Peek it sees
+// the address like the CPU puts it on the bus: masked by the model and from the I/O port of the MOS6510.
+func (cpu *SixFiveOTwo) Peek(mem Memory, address Address) Word {
+	pins := modelPins{cpu: cpu, mem: mem}
+	return pins.Peek(address & cpu.Model.addressMask())
+}
+
+// FetchWordFromProgramCounter fetches a Word from Memory at the ProgramCounter and increments it
+func (cpu *SixFiveOTwo) FetchWordFromProgramCounter(mem Memory) Word {
+	data := cpu.FetchWord(cpu.programMemory(mem), cpu.ProgramCounter)
+	cpu.ProgramCounter++
+	return data
+}
+
+// dummyRead reads from the address and throws the value away. The 6502 has no idle bus cycles,
+// it reads from some address whenever it is busy internally, which matters for memory mapped I/O.
+func (cpu *SixFiveOTwo) dummyRead(mem Memory, address Address) {
+	cpu.FetchWord(mem, address)
+}
+
+// programRead is a dummyRead from the program, on the 65816 it reads from the ProgramBank (see programMemory).
+func (cpu *SixFiveOTwo) programRead(mem Memory, address Address) {
+	cpu.dummyRead(cpu.programMemory(mem), address)
+}
+
+// pins returns the Memory as the Bus of the model sees it, see modelPins. The addresses are masked before
+// they reach the Bus, so the watches see them like the Memory does.
+func (cpu *SixFiveOTwo) pins(mem Memory) busMemory {
+	if cpu.Model != MOS6510 {
+		return mem
+	}
+	cpu.modelPins = modelPins{cpu: cpu, mem: mem}
+	return &cpu.modelPins
+}
+
+// modelPins puts the I/O port of the MOS6510 between the Bus and the Memory, see Model for the differences
+// between the models.
+type modelPins struct {
+	cpu *SixFiveOTwo
+	mem Memory
+}
+
+func (p *modelPins) ReadWord(address Address) Word {
+	if p.cpu.Model == MOS6510 && address <= ioPortData {
+		return p.cpu.Port.read(address)
+	}
+	return p.mem.ReadWord(address)
+}
+
+func (p *modelPins) WriteWord(address Address, value Word) {
+	if p.cpu.Model == MOS6510 && address <= ioPortData {
+		p.cpu.Port.write(address, value)
+	}
+	p.mem.WriteWord(address, value)
+}
+
+// Peek reads the I/O port like ReadWord does, reading it has no side effects.
+func (p *modelPins) Peek(address Address) Word {
+	if p.cpu.Model == MOS6510 && address <= ioPortData {
+		return p.cpu.Port.read(address)
+	}
+	return p.mem.Peek(address)
+}
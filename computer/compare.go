@@ -0,0 +1,29 @@
+package computer
+
+// compare compares a register with a value and sets the flags like a subtraction without borrow would.
+// The carry flag is set if the register is greater than or equal to the value.
+func (cpu *SixFiveOTwo) compare(register Word, value Word) {
+	cpu.Status.SetCarryFlag(register >= value)
+	cpu.evaluateAndSetStatusFlags(register - value)
+}
+
+// cmp CMP - Compare
+//
+// This instruction compares the contents of the accumulator with another memory held value and sets the zero and carry flags as appropriate.
+func (cpu *SixFiveOTwo) cmp(mem Memory, op operand) {
+	cpu.compare(cpu.Accumulator, cpu.readOperand(mem, op))
+}
+
+// cpx CPX - Compare X Register
+//
+// This instruction compares the contents of the X register with another memory held value and sets the zero and carry flags as appropriate.
+func (cpu *SixFiveOTwo) cpx(mem Memory, op operand) {
+	cpu.compare(cpu.RegisterX, cpu.readOperand(mem, op))
+}
+
+// cpy CPY - Compare Y Register
+//
+// This instruction compares the contents of the Y register with another memory held value and sets the zero and carry flags as appropriate.
+func (cpu *SixFiveOTwo) cpy(mem Memory, op operand) {
+	cpu.compare(cpu.RegisterY, cpu.readOperand(mem, op))
+}
@@ -0,0 +1,80 @@
+package computer
+
+// jmp JMP - Jump
+//
+// Sets the program counter to the address specified by the operand.
+func (cpu *SixFiveOTwo) jmp(_ Memory, op operand) {
+	cpu.ProgramCounter = op.address
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
+
+// jsr JSR - Jump to Subroutine
+//
+// The JSR instruction pushes the address (minus one) of the return point on to the stack and then
+// sets the program counter to the target memory address.
+//
+// The operand is not resolved in advance: the 6502 fetches the low byte of the target, keeps it while it
+// sets up the stack and pushes the return address, and fetches the high byte last. The return address
+// is the address of the high byte.
+func (cpu *SixFiveOTwo) jsr(mem Memory, _ operand) {
+	lsb := cpu.FetchWordFromProgramCounter(mem)
+	cpu.stackRead(mem)
+	cpu.pushAddress(mem, cpu.ProgramCounter)
+	msb := cpu.FetchWordFromProgramCounter(mem)
+	cpu.ProgramCounter = Address(msb)<<8 | Address(lsb)
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
+
+// rts RTS - Return from Subroutine
+//
+// The RTS instruction is used at the end of a subroutine to return to the calling routine.
+// It pulls the program counter (minus one) from the stack.
+func (cpu *SixFiveOTwo) rts(mem Memory, _ operand) {
+	cpu.stackRead(mem)
+	cpu.ProgramCounter = cpu.pullAddress(mem)
+	// The 6502 increments the pulled address in an extra cycle, it reads the pulled address meanwhile
+	cpu.programRead(mem, cpu.ProgramCounter)
+	cpu.ProgramCounter++
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
+
+// nop NOP - No Operation
+//
+// The NOP instruction causes no changes to the processor other than the normal incrementing of the
+// program counter to the next instruction.
+// The undocumented NOPs with an operand read it like a load would, including the page crossing penalty.
+func (cpu *SixFiveOTwo) nop(mem Memory, op operand) {
+	if op.mode != Implied {
+		cpu.readOperand(mem, op)
+	}
+}
+
+// nopLong NOP - No Operation
+//
+// The reserved opcode $5C of the 65C02 reads its absolute operand and then keeps the bus busy for 8 cycles in total,
+// it keeps reading the operand.
+func (cpu *SixFiveOTwo) nopLong(mem Memory, op operand) {
+	cpu.readOperand(mem, op)
+	for i := 0; i < 4; i++ {
+		cpu.dummyRead(cpu.operandMemory(mem, op), op.address)
+	}
+}
+
+// wai WAI - Wait for Interrupt
+//
+// Stops executing instructions until an interrupt is signalled (65C02 only). If interrupts are disabled
+// an IRQ is not taken, execution just continues with the next instruction.
+func (cpu *SixFiveOTwo) wai(mem Memory, _ operand) {
+	cpu.programRead(mem, cpu.ProgramCounter)
+	cpu.waiting = true
+	cpu.logger.LogE("Waiting for interrupt\n")
+}
+
+// stp STP - Stop the Clock
+//
+// Halts the CPU until it is reset (65C02 only), see JamError.
+func (cpu *SixFiveOTwo) stp(mem Memory, _ operand) {
+	cpu.programRead(mem, cpu.ProgramCounter)
+	cpu.ProgramCounter--
+	cpu.jammed = &JamError{ProgramCounter: cpu.ProgramCounter}
+}
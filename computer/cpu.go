@@ -2,7 +2,6 @@ package computer
 
 import (
 	"fmt"
-	"os"
 	"strings"
 )
 
@@ -20,7 +19,8 @@ func (a Address) String() string {
 
 // SixFiveOTwo represents the 6502 CPU
 type SixFiveOTwo struct {
-	Cycle uint
+	// Bus performs the accesses to the Memory and counts their cycles
+	Bus
 
 	// ProgramCounter
 	// The program counter is a 16 bit register which points to the next instruction to be executed. The value of program counter is modified automatically as instructions are executed.
@@ -54,12 +54,46 @@ type SixFiveOTwo struct {
 
 	Status ProcessorStatus
 
-	logger CpuLogger
+	// Model is the variant of the 6502 that is emulated, the NMOS MOS6502 by default
+	Model Model
+	// Port is the on-chip I/O port of the MOS6510, it is not used by the other models
+	Port IOPort
+
+	// IllegalOpcodePolicy decides what happens when an opcode is fetched that the CPU does not know
+	IllegalOpcodePolicy IllegalOpcodePolicy
+	// OnIllegalOpcode is called for illegal opcodes if the IllegalOpcodePolicy is CallbackOnIllegalOpcode
+	OnIllegalOpcode func(cpu *SixFiveOTwo, err *IllegalOpcodeError) error
+	// UndocumentedOpcodes makes the CPU execute the undocumented NMOS opcodes (see LookupUndocumentedOpcode)
+	// instead of treating them as illegal opcodes
+	UndocumentedOpcodes bool
+	// VerifyCycles makes Step check the cycles of every instruction against the opcode table, the cycles for
+	// crossing a page, taking a branch and the decimal mode of the 65C02 are derived from the operand and the
+	// flags (see CycleVerificationError)
+	VerifyCycles bool
+
+	// jammed is set once a JAM or STP opcode halted the CPU, only Reset clears it
+	jammed *JamError
+	// waiting is set by WAI until an interrupt is signalled
+	waiting bool
+
+	// modelPins connects the Bus to the Memory for the models with an I/O port, see pins
+	modelPins modelPins
+	// banks are the bank registers of the 65816 while it runs an instruction on the core, nil for the 6502
+	banks *emulationBanks
+
+	// cycleDebt is the number of cycles RunCycles ran past its last budget
+	cycleDebt uint
+
+	// tickMemory is the Memory of the latest Tick, the next instruction runs on it
+	tickMemory Memory
+
+	// irq is the state of the IRQ line, nmiPending is set by a falling edge on the NMI line
+	irq, nmiPending bool
 }
 
 func NewSixFiveOTwo(logger CpuLogger) *SixFiveOTwo {
 	return &SixFiveOTwo{
-		logger: logger,
+		Bus: Bus{logger: logger},
 	}
 }
 
@@ -98,27 +132,58 @@ func (cpu *SixFiveOTwo) Short() string {
 	return sb.String()
 }
 
-// Reset initializes the CPU to its initial state
-func (cpu *SixFiveOTwo) Reset(mem Memory) {
-	_ = mem.Init()
-	cpu.ProgramCounter = 0xFFFC
-	cpu.StackPointer = 0xFF
+// PowerOn brings the CPU into the state after switching on the machine and runs the Reset sequence.
+//
+// Registers, flags, the cycle counter, the interrupt lines and the data register of the I/O port are cleared.
+// The content of memory is not touched, so the program and the reset vector have to be in place already.
+func (cpu *SixFiveOTwo) PowerOn(mem Memory) {
+	cpu.Stop()
+	cpu.Cycle = 0
+	cpu.StackPointer = 0
 	cpu.Accumulator = 0
 	cpu.RegisterX = 0
 	cpu.RegisterY = 0
 	cpu.Status.Reset()
-	cpu.Cycle = 0
-
+	cpu.irq = false
+	cpu.Port.Data = 0
+	cpu.Reset(mem)
 }
 
-func (cpu *SixFiveOTwo) addCycle() {
-	cpu.Cycle = cpu.Cycle + 1
-	cpu.logger.SetCycle(cpu.Cycle)
+// Reset runs the reset sequence of the 6502, this is a warm reset of a running machine.
+//
+// Like the real CPU the reset takes 7 cycles: it runs through the motions of an interrupt without
+// writing to the stack, so the StackPointer ends up decremented by three. The interrupt disable flag is
+// set (the 65C02 also clears the decimal flag) and the ProgramCounter is loaded from the ResetVector at $FFFC/$FFFD.
+// The MOS6510 makes all pins of its I/O port inputs again.
+// The other registers, the remaining flags and memory keep their value. A CPU halted by a JAM opcode runs again.
+// An instruction that Tick left in the middle is finished first.
+func (cpu *SixFiveOTwo) Reset(mem Memory) {
+	cpu.Stop()
+	cpu.jammed = nil
+	cpu.waiting = false
+	cpu.nmiPending = false
+	if cpu.Model == MOS6510 {
+		cpu.Port.reset()
+	}
+	cpu.programRead(mem, cpu.ProgramCounter)
+	cpu.programRead(mem, cpu.ProgramCounter)
+	for i := 0; i < 3; i++ {
+		cpu.stackRead(mem)
+		cpu.StackPointer--
+	}
+	cpu.Status.SetInterruptDisableFlag(true)
+	if cpu.Model.cmos() {
+		cpu.Status.SetDecimalFlag(false)
+	}
+	cpu.ProgramCounter = cpu.readPointer(mem, ResetVector, ResetVector+1)
+	cpu.logger.LogE("RESET %s\n", cpu.ProgramCounter)
 }
 
 // FetchInstruction fetches the next instruction from memory
 func (cpu *SixFiveOTwo) FetchInstruction(mem Memory) Instruction {
-	return Instruction(cpu.FetchWordFromProgramCounter(mem))
+	instruction := Instruction(cpu.busRead(cpu.programMemory(mem), cpu.ProgramCounter, WatchExecute))
+	cpu.ProgramCounter++
+	return instruction
 }
 
 // FetchAddress fetches a Address from Memory and increments the ProgramCounter
@@ -131,27 +196,9 @@ func (cpu *SixFiveOTwo) FetchInstruction(mem Memory) Instruction {
 	---------------+----------------+------------------------------------
 */
 func (cpu *SixFiveOTwo) FetchAddress(mem Memory) Address {
-	addr := mem.ReadAddress(cpu.ProgramCounter)
-	cpu.addCycle()
-	cpu.ProgramCounter++
-	cpu.addCycle()
-	cpu.ProgramCounter++
-	return addr
-}
-
-// FetchWord fetches a Word from Memory at the specified address
-func (cpu *SixFiveOTwo) FetchWord(mem Memory, address Address) Word {
-	data := mem.ReadWord(address)
-	cpu.addCycle()
-	return data
-}
-
-// FetchWordFromProgramCounter fetches a Word from Memory at the ProgramCounter and increments it
-func (cpu *SixFiveOTwo) FetchWordFromProgramCounter(mem Memory) Word {
-	data := mem.ReadWord(cpu.ProgramCounter)
-	cpu.ProgramCounter++
-	cpu.addCycle()
-	return data
+	lsb := cpu.FetchWordFromProgramCounter(mem)
+	msb := cpu.FetchWordFromProgramCounter(mem)
+	return Address(msb)<<8 | Address(lsb)
 }
 
 // evaluateAndSetStatusFlags updates the Zero (Z) and Negative (N) flags
@@ -216,6 +263,26 @@ func (cpu *SixFiveOTwo) loadIntoRegisterFromAdress(reg *Word, mem Memory, addres
 	cpu.evaluateAndSetStatusFlags(data)
 }
 
+// loadIntoRegisterFromOperand reads the value of a resolved operand (see resolve) and stores
+// it into the specified register.
+// It then updates the Zero (Z) and Negative (N) status flags based on the value
+// that was loaded, by calling evaluateAndSetStatusFlags.
+//
+// Parameters:
+//
+//	reg: A pointer to the target CPU register (e.g., &cpu.Accumulator, &cpu.RegisterX, &cpu.RegisterY).
+//	mem: A pointer to the Memory interface/struct used for fetching the byte.
+//	op: The operand of the instruction as returned by resolve.
+//
+// Side Effects:
+//   - Modifies the value of the register pointed to by `reg`.
+//   - Spends the cycles needed to read the operand, including the page crossing penalty.
+//   - Modifies cpu.Status (specifically the Z and N flags) via the call
+//     to evaluateAndSetStatusFlags.
+func (cpu *SixFiveOTwo) loadIntoRegisterFromOperand(reg *Word, mem Memory, op operand) {
+	cpu.loadIntoRegisterImmediate(reg, cpu.readOperand(mem, op))
+}
+
 // loadIntoRegisterImmediate directly loads a given byte into the specified register
 // without fetching from memory.
 //
@@ -236,73 +303,28 @@ func (cpu *SixFiveOTwo) loadIntoRegisterImmediate(reg *Word, data Word) {
 	cpu.evaluateAndSetStatusFlags(data)
 }
 
-// Execute runs the CPU for the specified number of cycles
-func (cpu *SixFiveOTwo) Execute(cyclesToRun uint, mem Memory, verbose bool) {
+// Execute runs whole instructions until at least cyclesToRun cycles have passed,
+// with 0 it runs until an error occurs. If verbose is set the CPU state is logged after every instruction.
+//
+// Execution stops early with an error if an illegal opcode is fetched, see IllegalOpcodePolicy.
+// Use RunCycles to run an exact cycle budget over multiple calls.
+func (cpu *SixFiveOTwo) Execute(cyclesToRun uint, mem Memory, verbose bool) error {
 	executionEnd := cpu.Cycle + cyclesToRun
-	for cyclesToRun == 0 || cpu.Cycle <= executionEnd {
-		instruction := cpu.FetchInstruction(mem)
-		cpu.logger.LogE("%s\n", instruction)
-		switch instruction {
-		case LDX_I:
-			cpu.loadIntoRegister(&cpu.RegisterX, mem)
-			cpu.logger.LogE("%s\n", cpu.RegisterX)
-		case LDA_I:
-			cpu.loadIntoRegister(&cpu.Accumulator, mem)
-			cpu.logger.LogE("%s\n", cpu.Accumulator)
-		case LDA_Z:
-			zpAdress := cpu.FetchWordFromProgramCounter(mem)
-			cpu.logger.LogE("ZP: %s", zpAdress)
-
-			cpu.loadIntoRegisterFromAdress(&cpu.Accumulator, mem, Address(zpAdress))
-			cpu.logger.LogE("%s\n", cpu.Accumulator)
-		case ADC_ZX:
-			nextWord := cpu.FetchWordFromProgramCounter(mem)
-			addrOfValue := cpu.RegisterX + nextWord
-			if addrOfValue < cpu.RegisterX {
-				cpu.logger.LogE("Page crossed\n")
-				cpu.addCycle()
-			}
-			cpu.logger.LogE("%v+%v\n", cpu.RegisterX, nextWord)
-			cpu.logger.LogE("Calculated Addr: %v\n", addrOfValue)
-
-			lhs := cpu.FetchWord(mem, Address(addrOfValue))
-			cpu.logger.LogE("Loaded Value: %v\n", lhs)
-			cpu.addCycle()
-
-			res := lhs + cpu.Accumulator
-			oldAcc := cpu.Accumulator
-			cpu.loadIntoRegisterImmediate(&cpu.Accumulator, res)
-			if cpu.Accumulator < oldAcc {
-				cpu.Status.SetCarryFlag(true)
-			}
-
-			cpu.logger.LogE("Result %s\n", cpu.Accumulator)
-			fmt.Printf("  A(%s) + RHS(%s) = A(%s)\n", oldAcc, lhs, res)
-		case JMP_ABS:
-			fmt.Printf("cc: %d", cpu.Cycle)
-			cpu.ProgramCounter = cpu.FetchAddress(mem)
-			cpu.addCycle()
-			cpu.logger.LogE("%s", cpu.ProgramCounter)
-
-		case JMP_IND:
-			fmt.Printf("cc: %d", cpu.Cycle)
-			cpu.ProgramCounter = cpu.FetchAddress(mem)
-			cpu.ProgramCounter = cpu.FetchAddress(mem)
-			cpu.addCycle()
-			cpu.logger.LogE("%s", cpu.ProgramCounter.String())
-
-		default:
-			cpu.logger.LogE("\n===============\n")
-			cpu.logger.LogE("CPU CRASHED\n")
-			cpu.logger.LogE("%s\n", instruction)
-			os.Exit(1)
+	for cyclesToRun == 0 || cpu.Cycle < executionEnd {
+		if _, err := cpu.Step(mem); err != nil {
+			return err
+		}
+		if verbose {
+			cpu.logger.LogS("%s", cpu.Short())
 		}
 	}
+	return nil
 }
 
-func (cpu SixFiveOTwo) AssertCycle(cycle uint) {
+// AssertCycle returns a *CycleMismatchError if the CPU is not in the given cycle
+func (cpu SixFiveOTwo) AssertCycle(cycle uint) error {
 	if cpu.Cycle != cycle {
-		_, _ = fmt.Fprintf(os.Stderr, "CPU is in the wrong cycle %d expected %d", cpu.Cycle, cycle)
-		os.Exit(-1)
+		return &CycleMismatchError{Expected: cycle, Actual: cpu.Cycle}
 	}
+	return nil
 }
@@ -0,0 +1,114 @@
+package computer
+
+import "fmt"
+
+// IllegalOpcodeError is returned when the CPU fetches an opcode that is not part of its instruction set.
+type IllegalOpcodeError struct {
+	// ProgramCounter is the address the opcode was fetched from
+	ProgramCounter Address
+	Opcode         Instruction
+}
+
+func (e *IllegalOpcodeError) Error() string {
+	return fmt.Sprintf("illegal opcode %s at %s", Word(e.Opcode), e.ProgramCounter)
+}
+
+// JamError is returned when the CPU executed one of the undocumented JAM opcodes or the STP instruction of the 65C02.
+// The CPU stays halted until it is reset.
+type JamError struct {
+	// ProgramCounter is the address the opcode was fetched from
+	ProgramCounter Address
+	Opcode         Instruction
+}
+
+func (e *JamError) Error() string {
+	return fmt.Sprintf("CPU jammed by opcode %s at %s", Word(e.Opcode), e.ProgramCounter)
+}
+
+// CycleMismatchError is returned by AssertCycle if the CPU is not in the expected cycle.
+type CycleMismatchError struct {
+	Expected, Actual uint
+}
+
+func (e *CycleMismatchError) Error() string {
+	return fmt.Sprintf("CPU is in the wrong cycle %d expected %d", e.Actual, e.Expected)
+}
+
+// CycleVerificationError is returned by Step if SixFiveOTwo.VerifyCycles is set and an instruction took
+// a different number of cycles than its entry in the opcode table and the penalties it paid.
+type CycleVerificationError struct {
+	// ProgramCounter is the address the opcode was fetched from
+	ProgramCounter   Address
+	Opcode           Instruction
+	Expected, Actual uint
+}
+
+func (e *CycleVerificationError) Error() string {
+	return fmt.Sprintf("opcode %s at %s took %d cycles expected %d", Word(e.Opcode), e.ProgramCounter, e.Actual, e.Expected)
+}
+
+// MappingError is returned by MemoryMap.Map if a Device can not be mapped to the region.
+type MappingError struct {
+	Start, End Address
+	Reason     string
+}
+
+func (e *MappingError) Error() string {
+	return fmt.Sprintf("can not map %s - %s: %s", e.Start, e.End, e.Reason)
+}
+
+// DeviceError is returned by the constructors of Devices if the configuration can not work, e.g. a
+// BankSwitch without banks.
+type DeviceError struct {
+	Device string
+	Reason string
+}
+
+func (e *DeviceError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Device, e.Reason)
+}
+
+// SnapshotError is returned by Snapshotter.Restore if the state is not a Snapshot of the target.
+type SnapshotError struct {
+	Target any
+	State  any
+}
+
+func (e *SnapshotError) Error() string {
+	return fmt.Sprintf("can not restore %T from a snapshot of type %T", e.Target, e.State)
+}
+
+// IllegalOpcodePolicy decides what the CPU does when it fetches an illegal opcode.
+type IllegalOpcodePolicy uint8
+
+const (
+	// HaltOnIllegalOpcode stops execution and returns an *IllegalOpcodeError.
+	// The ProgramCounter is left pointing at the opcode. This is the default.
+	HaltOnIllegalOpcode IllegalOpcodePolicy = iota
+	// NopOnIllegalOpcode executes the opcode as a single byte NOP taking 2 cycles.
+	NopOnIllegalOpcode
+	// CallbackOnIllegalOpcode passes the *IllegalOpcodeError to SixFiveOTwo.OnIllegalOpcode.
+	// Execution continues after the opcode if the callback returns nil, otherwise the returned error stops it.
+	// Without a callback the CPU halts.
+	CallbackOnIllegalOpcode
+)
+
+// illegalOpcode applies the IllegalOpcodePolicy to an opcode without handler.
+// The opcode has been fetched already.
+func (cpu *SixFiveOTwo) illegalOpcode(mem Memory, instruction Instruction) error {
+	err := &IllegalOpcodeError{ProgramCounter: cpu.ProgramCounter - 1, Opcode: instruction}
+	cpu.logger.LogE("%s\n", err)
+
+	switch cpu.IllegalOpcodePolicy {
+	case NopOnIllegalOpcode:
+		cpu.resolve(mem, Implied)
+		return nil
+	case CallbackOnIllegalOpcode:
+		if cpu.OnIllegalOpcode == nil {
+			break
+		}
+		return cpu.OnIllegalOpcode(cpu, err)
+	}
+	cpu.ProgramCounter = err.ProgramCounter
+	return err
+}
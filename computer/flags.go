@@ -0,0 +1,36 @@
+package computer
+
+// clc CLC - Clear Carry Flag
+func (cpu *SixFiveOTwo) clc(_ Memory, _ operand) {
+	cpu.Status.SetCarryFlag(false)
+}
+
+// sec SEC - Set Carry Flag
+func (cpu *SixFiveOTwo) sec(_ Memory, _ operand) {
+	cpu.Status.SetCarryFlag(true)
+}
+
+// cli CLI - Clear Interrupt Disable
+func (cpu *SixFiveOTwo) cli(_ Memory, _ operand) {
+	cpu.Status.SetInterruptDisableFlag(false)
+}
+
+// sei SEI - Set Interrupt Disable
+func (cpu *SixFiveOTwo) sei(_ Memory, _ operand) {
+	cpu.Status.SetInterruptDisableFlag(true)
+}
+
+// cld CLD - Clear Decimal Mode
+func (cpu *SixFiveOTwo) cld(_ Memory, _ operand) {
+	cpu.Status.SetDecimalFlag(false)
+}
+
+// sed SED - Set Decimal Flag
+func (cpu *SixFiveOTwo) sed(_ Memory, _ operand) {
+	cpu.Status.SetDecimalFlag(true)
+}
+
+// clv CLV - Clear Overflow Flag
+func (cpu *SixFiveOTwo) clv(_ Memory, _ operand) {
+	cpu.Status.SetOverflowFlag(false)
+}
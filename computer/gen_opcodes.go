@@ -0,0 +1,1036 @@
+//go:build ignore
+
+// gen_opcodes generates instructions.go, instruction_string.go and opcode_table.go
+// from the opcode table below. Adding an opcode means adding a line to the table
+// and running `go generate ./...`.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// table lists every opcode known to the emulator.
+//
+//	opcode   - the opcode byte
+//	mnemonic - the assembler mnemonic
+//	mode     - the AddressingMode constant
+//	cycles   - the base cycle count as published in the data sheets
+//	handler  - the SixFiveOTwo method implementing the instruction, or - if it is not implemented yet
+//	name     - optional, the name of the Instruction constant
+//
+// The Instruction constant is named after the mnemonic and the addressing mode (e.g. LDA_ZX).
+// Opcodes that share mnemonic and addressing mode with another one need an explicit name.
+const table = `
+# opcode mnemonic mode            cycles handler
+0x69     ADC      Immediate       2      adc
+0x65     ADC      ZeroPage        3      adc
+0x75     ADC      ZeroPageX       4      adc
+0x6D     ADC      Absolute        4      adc
+0x7D     ADC      AbsoluteX       4      adc
+0x79     ADC      AbsoluteY       4      adc
+0x61     ADC      IndexedIndirect 6      adc
+0x71     ADC      IndirectIndexed 5      adc
+
+0x29     AND      Immediate       2      and
+0x25     AND      ZeroPage        3      and
+0x35     AND      ZeroPageX       4      and
+0x2D     AND      Absolute        4      and
+0x3D     AND      AbsoluteX       4      and
+0x39     AND      AbsoluteY       4      and
+0x21     AND      IndexedIndirect 6      and
+0x31     AND      IndirectIndexed 5      and
+
+0x0A     ASL      Accumulator     2      asl
+0x06     ASL      ZeroPage        5      asl
+0x16     ASL      ZeroPageX       6      asl
+0x0E     ASL      Absolute        6      asl
+0x1E     ASL      AbsoluteX       7      asl
+
+0x90     BCC      Relative        2      bcc
+0xB0     BCS      Relative        2      bcs
+0xF0     BEQ      Relative        2      beq
+
+0x24     BIT      ZeroPage        3      bit
+0x2C     BIT      Absolute        4      bit
+
+0x30     BMI      Relative        2      bmi
+0xD0     BNE      Relative        2      bne
+0x10     BPL      Relative        2      bpl
+
+0x00     BRK      Implied         7      brk
+
+0x50     BVC      Relative        2      bvc
+0x70     BVS      Relative        2      bvs
+
+0x18     CLC      Implied         2      clc
+0xD8     CLD      Implied         2      cld
+0x58     CLI      Implied         2      cli
+0xB8     CLV      Implied         2      clv
+
+0xC9     CMP      Immediate       2      cmp
+0xC5     CMP      ZeroPage        3      cmp
+0xD5     CMP      ZeroPageX       4      cmp
+0xCD     CMP      Absolute        4      cmp
+0xDD     CMP      AbsoluteX       4      cmp
+0xD9     CMP      AbsoluteY       4      cmp
+0xC1     CMP      IndexedIndirect 6      cmp
+0xD1     CMP      IndirectIndexed 5      cmp
+
+0xE0     CPX      Immediate       2      cpx
+0xE4     CPX      ZeroPage        3      cpx
+0xEC     CPX      Absolute        4      cpx
+
+0xC0     CPY      Immediate       2      cpy
+0xC4     CPY      ZeroPage        3      cpy
+0xCC     CPY      Absolute        4      cpy
+
+0xC6     DEC      ZeroPage        5      dec
+0xD6     DEC      ZeroPageX       6      dec
+0xCE     DEC      Absolute        6      dec
+0xDE     DEC      AbsoluteX       7      dec
+
+0xCA     DEX      Implied         2      dex
+0x88     DEY      Implied         2      dey
+
+0x49     EOR      Immediate       2      eor
+0x45     EOR      ZeroPage        3      eor
+0x55     EOR      ZeroPageX       4      eor
+0x4D     EOR      Absolute        4      eor
+0x5D     EOR      AbsoluteX       4      eor
+0x59     EOR      AbsoluteY       4      eor
+0x41     EOR      IndexedIndirect 6      eor
+0x51     EOR      IndirectIndexed 5      eor
+
+0xE6     INC      ZeroPage        5      inc
+0xF6     INC      ZeroPageX       6      inc
+0xEE     INC      Absolute        6      inc
+0xFE     INC      AbsoluteX       7      inc
+
+0xE8     INX      Implied         2      inx
+0xC8     INY      Implied         2      iny
+
+0x4C     JMP      Absolute        3      jmp
+0x6C     JMP      Indirect        5      jmp
+
+0x20     JSR      Absolute        6      jsr
+
+0xA9     LDA      Immediate       2      lda
+0xA5     LDA      ZeroPage        3      lda
+0xB5     LDA      ZeroPageX       4      lda
+0xAD     LDA      Absolute        4      lda
+0xBD     LDA      AbsoluteX       4      lda
+0xB9     LDA      AbsoluteY       4      lda
+0xA1     LDA      IndexedIndirect 6      lda
+0xB1     LDA      IndirectIndexed 5      lda
+
+0xA2     LDX      Immediate       2      ldx
+0xA6     LDX      ZeroPage        3      ldx
+0xB6     LDX      ZeroPageY       4      ldx
+0xAE     LDX      Absolute        4      ldx
+0xBE     LDX      AbsoluteY       4      ldx
+
+0xA0     LDY      Immediate       2      ldy
+0xA4     LDY      ZeroPage        3      ldy
+0xB4     LDY      ZeroPageX       4      ldy
+0xAC     LDY      Absolute        4      ldy
+0xBC     LDY      AbsoluteX       4      ldy
+
+0x4A     LSR      Accumulator     2      lsr
+0x46     LSR      ZeroPage        5      lsr
+0x56     LSR      ZeroPageX       6      lsr
+0x4E     LSR      Absolute        6      lsr
+0x5E     LSR      AbsoluteX       7      lsr
+
+0xEA     NOP      Implied         2      nop
+
+0x09     ORA      Immediate       2      ora
+0x05     ORA      ZeroPage        3      ora
+0x15     ORA      ZeroPageX       4      ora
+0x0D     ORA      Absolute        4      ora
+0x1D     ORA      AbsoluteX       4      ora
+0x19     ORA      AbsoluteY       4      ora
+0x01     ORA      IndexedIndirect 6      ora
+0x11     ORA      IndirectIndexed 5      ora
+
+0x48     PHA      Implied         3      pha
+0x08     PHP      Implied         3      php
+0x68     PLA      Implied         4      pla
+0x28     PLP      Implied         4      plp
+
+0x2A     ROL      Accumulator     2      rol
+0x26     ROL      ZeroPage        5      rol
+0x36     ROL      ZeroPageX       6      rol
+0x2E     ROL      Absolute        6      rol
+0x3E     ROL      AbsoluteX       7      rol
+
+0x6A     ROR      Accumulator     2      ror
+0x66     ROR      ZeroPage        5      ror
+0x76     ROR      ZeroPageX       6      ror
+0x6E     ROR      Absolute        6      ror
+0x7E     ROR      AbsoluteX       7      ror
+
+0x40     RTI      Implied         6      rti
+0x60     RTS      Implied         6      rts
+
+0xE9     SBC      Immediate       2      sbc
+0xE5     SBC      ZeroPage        3      sbc
+0xF5     SBC      ZeroPageX       4      sbc
+0xED     SBC      Absolute        4      sbc
+0xFD     SBC      AbsoluteX       4      sbc
+0xF9     SBC      AbsoluteY       4      sbc
+0xE1     SBC      IndexedIndirect 6      sbc
+0xF1     SBC      IndirectIndexed 5      sbc
+
+0x38     SEC      Implied         2      sec
+0xF8     SED      Implied         2      sed
+0x78     SEI      Implied         2      sei
+
+0x85     STA      ZeroPage        3      sta
+0x95     STA      ZeroPageX       4      sta
+0x8D     STA      Absolute        4      sta
+0x9D     STA      AbsoluteX       5      sta
+0x99     STA      AbsoluteY       5      sta
+0x81     STA      IndexedIndirect 6      sta
+0x91     STA      IndirectIndexed 6      sta
+
+0x86     STX      ZeroPage        3      stx
+0x96     STX      ZeroPageY       4      stx
+0x8E     STX      Absolute        4      stx
+
+0x84     STY      ZeroPage        3      sty
+0x94     STY      ZeroPageX       4      sty
+0x8C     STY      Absolute        4      sty
+
+0xAA     TAX      Implied         2      tax
+0xA8     TAY      Implied         2      tay
+0xBA     TSX      Implied         2      tsx
+0x8A     TXA      Implied         2      txa
+0x9A     TXS      Implied         2      txs
+0x98     TYA      Implied         2      tya
+`
+
+// undocumentedTable lists the undocumented opcodes of the NMOS 6502 in the same format as table.
+// They are only executed if SixFiveOTwo.UndocumentedOpcodes is set.
+const undocumentedTable = `
+# opcode mnemonic mode            cycles handler name
+0x4B     ALR      Immediate       2      alr
+
+0x0B     ANC      Immediate       2      anc
+0x2B     ANC      Immediate       2      anc     ANC_2B
+
+0x8B     ANE      Immediate       2      ane
+
+0x6B     ARR      Immediate       2      arr
+
+0xC7     DCP      ZeroPage        5      dcp
+0xD7     DCP      ZeroPageX       6      dcp
+0xCF     DCP      Absolute        6      dcp
+0xDF     DCP      AbsoluteX       7      dcp
+0xDB     DCP      AbsoluteY       7      dcp
+0xC3     DCP      IndexedIndirect 8      dcp
+0xD3     DCP      IndirectIndexed 8      dcp
+
+0xE7     ISC      ZeroPage        5      isc
+0xF7     ISC      ZeroPageX       6      isc
+0xEF     ISC      Absolute        6      isc
+0xFF     ISC      AbsoluteX       7      isc
+0xFB     ISC      AbsoluteY       7      isc
+0xE3     ISC      IndexedIndirect 8      isc
+0xF3     ISC      IndirectIndexed 8      isc
+
+0x02     JAM      Implied         2      jam     JAM_02
+0x12     JAM      Implied         2      jam     JAM_12
+0x22     JAM      Implied         2      jam     JAM_22
+0x32     JAM      Implied         2      jam     JAM_32
+0x42     JAM      Implied         2      jam     JAM_42
+0x52     JAM      Implied         2      jam     JAM_52
+0x62     JAM      Implied         2      jam     JAM_62
+0x72     JAM      Implied         2      jam     JAM_72
+0x92     JAM      Implied         2      jam     JAM_92
+0xB2     JAM      Implied         2      jam     JAM_B2
+0xD2     JAM      Implied         2      jam     JAM_D2
+0xF2     JAM      Implied         2      jam     JAM_F2
+
+0xBB     LAS      AbsoluteY       4      las
+
+0xA7     LAX      ZeroPage        3      lax
+0xB7     LAX      ZeroPageY       4      lax
+0xAF     LAX      Absolute        4      lax
+0xBF     LAX      AbsoluteY       4      lax
+0xA3     LAX      IndexedIndirect 6      lax
+0xB3     LAX      IndirectIndexed 5      lax
+
+0xAB     LXA      Immediate       2      lxa
+
+0x1A     NOP      Implied         2      nop     NOP_1A
+0x3A     NOP      Implied         2      nop     NOP_3A
+0x5A     NOP      Implied         2      nop     NOP_5A
+0x7A     NOP      Implied         2      nop     NOP_7A
+0xDA     NOP      Implied         2      nop     NOP_DA
+0xFA     NOP      Implied         2      nop     NOP_FA
+0x80     NOP      Immediate       2      nop     NOP_80
+0x82     NOP      Immediate       2      nop     NOP_82
+0x89     NOP      Immediate       2      nop     NOP_89
+0xC2     NOP      Immediate       2      nop     NOP_C2
+0xE2     NOP      Immediate       2      nop     NOP_E2
+0x04     NOP      ZeroPage        3      nop     NOP_04
+0x44     NOP      ZeroPage        3      nop     NOP_44
+0x64     NOP      ZeroPage        3      nop     NOP_64
+0x14     NOP      ZeroPageX       4      nop     NOP_14
+0x34     NOP      ZeroPageX       4      nop     NOP_34
+0x54     NOP      ZeroPageX       4      nop     NOP_54
+0x74     NOP      ZeroPageX       4      nop     NOP_74
+0xD4     NOP      ZeroPageX       4      nop     NOP_D4
+0xF4     NOP      ZeroPageX       4      nop     NOP_F4
+0x0C     NOP      Absolute        4      nop     NOP_0C
+0x1C     NOP      AbsoluteX       4      nop     NOP_1C
+0x3C     NOP      AbsoluteX       4      nop     NOP_3C
+0x5C     NOP      AbsoluteX       4      nop     NOP_5C
+0x7C     NOP      AbsoluteX       4      nop     NOP_7C
+0xDC     NOP      AbsoluteX       4      nop     NOP_DC
+0xFC     NOP      AbsoluteX       4      nop     NOP_FC
+
+0x27     RLA      ZeroPage        5      rla
+0x37     RLA      ZeroPageX       6      rla
+0x2F     RLA      Absolute        6      rla
+0x3F     RLA      AbsoluteX       7      rla
+0x3B     RLA      AbsoluteY       7      rla
+0x23     RLA      IndexedIndirect 8      rla
+0x33     RLA      IndirectIndexed 8      rla
+
+0x67     RRA      ZeroPage        5      rra
+0x77     RRA      ZeroPageX       6      rra
+0x6F     RRA      Absolute        6      rra
+0x7F     RRA      AbsoluteX       7      rra
+0x7B     RRA      AbsoluteY       7      rra
+0x63     RRA      IndexedIndirect 8      rra
+0x73     RRA      IndirectIndexed 8      rra
+
+0x87     SAX      ZeroPage        3      sax
+0x97     SAX      ZeroPageY       4      sax
+0x8F     SAX      Absolute        4      sax
+0x83     SAX      IndexedIndirect 6      sax
+
+0xEB     SBC      Immediate       2      sbc     SBC_EB
+
+0xCB     SBX      Immediate       2      sbx
+
+0x9F     SHA      AbsoluteY       5      sha
+0x93     SHA      IndirectIndexed 6      sha
+
+0x9E     SHX      AbsoluteY       5      shx
+
+0x9C     SHY      AbsoluteX       5      shy
+
+0x07     SLO      ZeroPage        5      slo
+0x17     SLO      ZeroPageX       6      slo
+0x0F     SLO      Absolute        6      slo
+0x1F     SLO      AbsoluteX       7      slo
+0x1B     SLO      AbsoluteY       7      slo
+0x03     SLO      IndexedIndirect 8      slo
+0x13     SLO      IndirectIndexed 8      slo
+
+0x47     SRE      ZeroPage        5      sre
+0x57     SRE      ZeroPageX       6      sre
+0x4F     SRE      Absolute        6      sre
+0x5F     SRE      AbsoluteX       7      sre
+0x5B     SRE      AbsoluteY       7      sre
+0x43     SRE      IndexedIndirect 8      sre
+0x53     SRE      IndirectIndexed 8      sre
+
+0x9B     TAS      AbsoluteY       5      tas
+`
+
+// cmosTable lists the opcodes of the WDC 65C02 that differ from table, all other opcodes are taken from table.
+// Besides the new instructions this includes the changed timing of JMP indirect and the shifts with
+// AbsoluteX addressing and the reserved opcodes, which are NOPs of different length on the 65C02.
+// Handlers can take constant arguments, e.g. rmb(3) calls cpu.rmb(mem, op, 3).
+const cmosTable = `
+# opcode mnemonic mode                    cycles handler name
+0x72     ADC      ZeroPageIndirect        5      adc
+
+0x32     AND      ZeroPageIndirect        5      and
+
+0x1E     ASL      AbsoluteX               6      asl
+
+0x0F     BBR0     ZeroPageRelative        5      bbr(0)
+0x1F     BBR1     ZeroPageRelative        5      bbr(1)
+0x2F     BBR2     ZeroPageRelative        5      bbr(2)
+0x3F     BBR3     ZeroPageRelative        5      bbr(3)
+0x4F     BBR4     ZeroPageRelative        5      bbr(4)
+0x5F     BBR5     ZeroPageRelative        5      bbr(5)
+0x6F     BBR6     ZeroPageRelative        5      bbr(6)
+0x7F     BBR7     ZeroPageRelative        5      bbr(7)
+
+0x8F     BBS0     ZeroPageRelative        5      bbs(0)
+0x9F     BBS1     ZeroPageRelative        5      bbs(1)
+0xAF     BBS2     ZeroPageRelative        5      bbs(2)
+0xBF     BBS3     ZeroPageRelative        5      bbs(3)
+0xCF     BBS4     ZeroPageRelative        5      bbs(4)
+0xDF     BBS5     ZeroPageRelative        5      bbs(5)
+0xEF     BBS6     ZeroPageRelative        5      bbs(6)
+0xFF     BBS7     ZeroPageRelative        5      bbs(7)
+
+0x89     BIT      Immediate               2      bit
+0x34     BIT      ZeroPageX               4      bit
+0x3C     BIT      AbsoluteX               4      bit
+
+0x80     BRA      Relative                3      bra
+
+0xD2     CMP      ZeroPageIndirect        5      cmp
+
+0x3A     DEC      Accumulator             2      dec
+
+0x52     EOR      ZeroPageIndirect        5      eor
+
+0x1A     INC      Accumulator             2      inc
+
+0x6C     JMP      Indirect                6      jmp
+0x7C     JMP      AbsoluteIndexedIndirect 6      jmp
+
+0xB2     LDA      ZeroPageIndirect        5      lda
+
+0x5E     LSR      AbsoluteX               6      lsr
+
+0x02     NOP      Immediate               2      nop     NOP_02
+0x22     NOP      Immediate               2      nop     NOP_22
+0x42     NOP      Immediate               2      nop     NOP_42
+0x62     NOP      Immediate               2      nop     NOP_62
+0x82     NOP      Immediate               2      nop     NOP_82
+0xC2     NOP      Immediate               2      nop     NOP_C2
+0xE2     NOP      Immediate               2      nop     NOP_E2
+0x44     NOP      ZeroPage                3      nop     NOP_44
+0x54     NOP      ZeroPageX               4      nop     NOP_54
+0xD4     NOP      ZeroPageX               4      nop     NOP_D4
+0xF4     NOP      ZeroPageX               4      nop     NOP_F4
+0x5C     NOP      Absolute                8      nopLong NOP_5C
+0xDC     NOP      Absolute                4      nop     NOP_DC
+0xFC     NOP      Absolute                4      nop     NOP_FC
+0x03     NOP      Implied                 1      nop     NOP_03
+0x0B     NOP      Implied                 1      nop     NOP_0B
+0x13     NOP      Implied                 1      nop     NOP_13
+0x1B     NOP      Implied                 1      nop     NOP_1B
+0x23     NOP      Implied                 1      nop     NOP_23
+0x2B     NOP      Implied                 1      nop     NOP_2B
+0x33     NOP      Implied                 1      nop     NOP_33
+0x3B     NOP      Implied                 1      nop     NOP_3B
+0x43     NOP      Implied                 1      nop     NOP_43
+0x4B     NOP      Implied                 1      nop     NOP_4B
+0x53     NOP      Implied                 1      nop     NOP_53
+0x5B     NOP      Implied                 1      nop     NOP_5B
+0x63     NOP      Implied                 1      nop     NOP_63
+0x6B     NOP      Implied                 1      nop     NOP_6B
+0x73     NOP      Implied                 1      nop     NOP_73
+0x7B     NOP      Implied                 1      nop     NOP_7B
+0x83     NOP      Implied                 1      nop     NOP_83
+0x8B     NOP      Implied                 1      nop     NOP_8B
+0x93     NOP      Implied                 1      nop     NOP_93
+0x9B     NOP      Implied                 1      nop     NOP_9B
+0xA3     NOP      Implied                 1      nop     NOP_A3
+0xAB     NOP      Implied                 1      nop     NOP_AB
+0xB3     NOP      Implied                 1      nop     NOP_B3
+0xBB     NOP      Implied                 1      nop     NOP_BB
+0xC3     NOP      Implied                 1      nop     NOP_C3
+0xD3     NOP      Implied                 1      nop     NOP_D3
+0xE3     NOP      Implied                 1      nop     NOP_E3
+0xEB     NOP      Implied                 1      nop     NOP_EB
+0xF3     NOP      Implied                 1      nop     NOP_F3
+0xFB     NOP      Implied                 1      nop     NOP_FB
+
+0x12     ORA      ZeroPageIndirect        5      ora
+
+0xDA     PHX      Implied                 3      phx
+0x5A     PHY      Implied                 3      phy
+0xFA     PLX      Implied                 4      plx
+0x7A     PLY      Implied                 4      ply
+
+0x07     RMB0     ZeroPage                5      rmb(0)
+0x17     RMB1     ZeroPage                5      rmb(1)
+0x27     RMB2     ZeroPage                5      rmb(2)
+0x37     RMB3     ZeroPage                5      rmb(3)
+0x47     RMB4     ZeroPage                5      rmb(4)
+0x57     RMB5     ZeroPage                5      rmb(5)
+0x67     RMB6     ZeroPage                5      rmb(6)
+0x77     RMB7     ZeroPage                5      rmb(7)
+
+0x3E     ROL      AbsoluteX               6      rol
+0x7E     ROR      AbsoluteX               6      ror
+
+0xF2     SBC      ZeroPageIndirect        5      sbc
+
+0x87     SMB0     ZeroPage                5      smb(0)
+0x97     SMB1     ZeroPage                5      smb(1)
+0xA7     SMB2     ZeroPage                5      smb(2)
+0xB7     SMB3     ZeroPage                5      smb(3)
+0xC7     SMB4     ZeroPage                5      smb(4)
+0xD7     SMB5     ZeroPage                5      smb(5)
+0xE7     SMB6     ZeroPage                5      smb(6)
+0xF7     SMB7     ZeroPage                5      smb(7)
+
+0x92     STA      ZeroPageIndirect        5      sta
+
+0xDB     STP      Implied                 3      stp
+
+0x64     STZ      ZeroPage                3      stz
+0x74     STZ      ZeroPageX               4      stz
+0x9C     STZ      Absolute                4      stz
+0x9E     STZ      AbsoluteX               5      stz
+
+0x14     TRB      ZeroPage                5      trb
+0x1C     TRB      Absolute                6      trb
+
+0x04     TSB      ZeroPage                5      tsb
+0x0C     TSB      Absolute                6      tsb
+
+0xCB     WAI      Implied                 3      wai
+`
+
+// w65816Table lists the opcodes of the WDC 65816 that differ from cmosTable, all other opcodes are taken
+// from the 65C02. The Rockwell bit instructions and the reserved NOPs are replaced by the long addressing
+// modes, the stack relative modes and the new instructions. The handlers are SixFiveEightSixteen methods,
+// the opcodes with the mnemonic and mode of a 65C02 opcode run its SixFiveOTwo handler in emulation mode.
+//
+// The cycles are the ones of 8 bit registers with the low byte of the direct page register being zero,
+// wide registers and an unaligned direct page cost extra cycles.
+const w65816Table = `
+# opcode mnemonic mode                    cycles handler name
+0x63     ADC      StackRelative           4      adc
+0x73     ADC      StackRelativeIndirectY  7      adc
+0x67     ADC      ZeroPageIndirectLong    6      adc
+0x77     ADC      ZeroPageIndirectLongY   6      adc
+0x6F     ADC      AbsoluteLong            5      adc
+0x7F     ADC      AbsoluteLongX           5      adc
+
+0x23     AND      StackRelative           4      and
+0x33     AND      StackRelativeIndirectY  7      and
+0x27     AND      ZeroPageIndirectLong    6      and
+0x37     AND      ZeroPageIndirectLongY   6      and
+0x2F     AND      AbsoluteLong            5      and
+0x3F     AND      AbsoluteLongX           5      and
+
+0x1E     ASL      AbsoluteX               7      asl
+
+0x82     BRL      RelativeLong            4      brl
+
+0xC3     CMP      StackRelative           4      cmp
+0xD3     CMP      StackRelativeIndirectY  7      cmp
+0xC7     CMP      ZeroPageIndirectLong    6      cmp
+0xD7     CMP      ZeroPageIndirectLongY   6      cmp
+0xCF     CMP      AbsoluteLong            5      cmp
+0xDF     CMP      AbsoluteLongX           5      cmp
+
+0x02     COP      Immediate               7      cop     COP
+
+0x43     EOR      StackRelative           4      eor
+0x53     EOR      StackRelativeIndirectY  7      eor
+0x47     EOR      ZeroPageIndirectLong    6      eor
+0x57     EOR      ZeroPageIndirectLongY   6      eor
+0x4F     EOR      AbsoluteLong            5      eor
+0x5F     EOR      AbsoluteLongX           5      eor
+
+0x5C     JML      AbsoluteLong            4      jmp
+0xDC     JML      AbsoluteIndirectLong    6      jmp
+
+0x6C     JMP      Indirect                5      jmp
+
+0x22     JSL      AbsoluteLong            8      jsl
+0xFC     JSR      AbsoluteIndexedIndirect 8      jsr
+
+0xA3     LDA      StackRelative           4      lda
+0xB3     LDA      StackRelativeIndirectY  7      lda
+0xA7     LDA      ZeroPageIndirectLong    6      lda
+0xB7     LDA      ZeroPageIndirectLongY   6      lda
+0xAF     LDA      AbsoluteLong            5      lda
+0xBF     LDA      AbsoluteLongX           5      lda
+
+0x5E     LSR      AbsoluteX               7      lsr
+
+0x54     MVN      BlockMove               7      mvn
+0x44     MVP      BlockMove               7      mvp
+
+0x03     ORA      StackRelative           4      ora
+0x13     ORA      StackRelativeIndirectY  7      ora
+0x07     ORA      ZeroPageIndirectLong    6      ora
+0x17     ORA      ZeroPageIndirectLongY   6      ora
+0x0F     ORA      AbsoluteLong            5      ora
+0x1F     ORA      AbsoluteLongX           5      ora
+
+0xF4     PEA      Absolute                5      pea     PEA
+0xD4     PEI      ZeroPage                6      pei     PEI
+0x62     PER      RelativeLong            6      per
+
+0x8B     PHB      Implied                 3      phb
+0x0B     PHD      Implied                 4      phd
+0x4B     PHK      Implied                 3      phk
+0xAB     PLB      Implied                 4      plb
+0x2B     PLD      Implied                 5      pld
+
+0xC2     REP      Immediate               3      rep     REP
+
+0x3E     ROL      AbsoluteX               7      rol
+0x7E     ROR      AbsoluteX               7      ror
+
+0x6B     RTL      Implied                 6      rtl
+
+0xE3     SBC      StackRelative           4      sbc
+0xF3     SBC      StackRelativeIndirectY  7      sbc
+0xE7     SBC      ZeroPageIndirectLong    6      sbc
+0xF7     SBC      ZeroPageIndirectLongY   6      sbc
+0xEF     SBC      AbsoluteLong            5      sbc
+0xFF     SBC      AbsoluteLongX           5      sbc
+
+0xE2     SEP      Immediate               3      sep     SEP
+
+0x83     STA      StackRelative           4      sta
+0x93     STA      StackRelativeIndirectY  7      sta
+0x87     STA      ZeroPageIndirectLong    6      sta
+0x97     STA      ZeroPageIndirectLongY   6      sta
+0x8F     STA      AbsoluteLong            5      sta
+0x9F     STA      AbsoluteLongX           5      sta
+
+0x5B     TCD      Implied                 2      tcd
+0x1B     TCS      Implied                 2      tcs
+0x7B     TDC      Implied                 2      tdc
+0x3B     TSC      Implied                 2      tsc
+0x9B     TXY      Implied                 2      txy
+0xBB     TYX      Implied                 2      tyx
+
+0x42     WDM      Immediate               2      nop     WDM
+
+0xEB     XBA      Implied                 3      xba
+0xFB     XCE      Implied                 2      xce
+`
+
+// descriptions are used for the comments on the generated Instruction constants.
+var descriptions = map[string]string{
+	"ADC": "Add with Carry",
+	"ALR": "AND then Logical Shift Right",
+	"ANC": "AND then copy Negative to Carry",
+	"AND": "Logical AND",
+	"ANE": "AND X and Immediate into Accumulator (unstable)",
+	"ARR": "AND then Rotate Right",
+	"ASL": "Arithmetic Shift Left",
+	"BBR": "Branch on Bit Reset",
+	"BBS": "Branch on Bit Set",
+	"BCC": "Branch if Carry Clear",
+	"BCS": "Branch if Carry Set",
+	"BEQ": "Branch if Equal",
+	"BIT": "Bit Test",
+	"BMI": "Branch if Minus",
+	"BNE": "Branch if Not Equal",
+	"BPL": "Branch if Positive",
+	"BRA": "Branch Always",
+	"BRK": "Force Interrupt",
+	"BRL": "Branch Always Long",
+	"BVC": "Branch if Overflow Clear",
+	"BVS": "Branch if Overflow Set",
+	"CLC": "Clear Carry Flag",
+	"CLD": "Clear Decimal Mode",
+	"CLI": "Clear Interrupt Disable",
+	"CLV": "Clear Overflow Flag",
+	"CMP": "Compare",
+	"COP": "Co-Processor Enable",
+	"CPX": "Compare X Register",
+	"CPY": "Compare Y Register",
+	"DCP": "Decrement then Compare",
+	"DEC": "Decrement Memory",
+	"DEX": "Decrement X Register",
+	"DEY": "Decrement Y Register",
+	"EOR": "Exclusive OR",
+	"INC": "Increment Memory",
+	"JML": "Jump Long",
+	"JSL": "Jump to Subroutine Long",
+	"INX": "Increment X Register",
+	"INY": "Increment Y Register",
+	"ISC": "Increment then Subtract with Carry",
+	"JAM": "Halt the CPU",
+	"JMP": "Jump",
+	"JSR": "Jump to Subroutine",
+	"LAS": "AND Stack Pointer into Accumulator, X and Stack Pointer",
+	"LAX": "Load Accumulator and X Register",
+	"LDA": "Load Accumulator",
+	"LDX": "Load X Register",
+	"LDY": "Load Y Register",
+	"LSR": "Logical Shift Right",
+	"MVN": "Block Move Next",
+	"MVP": "Block Move Previous",
+	"LXA": "AND Immediate into Accumulator and X (unstable)",
+	"NOP": "No Operation",
+	"ORA": "Logical Inclusive OR",
+	"PEA": "Push Effective Absolute Address",
+	"PEI": "Push Effective Indirect Address",
+	"PER": "Push Effective PC Relative Address",
+	"PHA": "Push Accumulator",
+	"PHB": "Push Data Bank Register",
+	"PHD": "Push Direct Page Register",
+	"PHK": "Push Program Bank Register",
+	"PHP": "Push Processor Status",
+	"PLA": "Pull Accumulator",
+	"PLB": "Pull Data Bank Register",
+	"PLD": "Pull Direct Page Register",
+	"PHX": "Push X Register",
+	"PHY": "Push Y Register",
+	"PLP": "Pull Processor Status",
+	"PLX": "Pull X Register",
+	"PLY": "Pull Y Register",
+	"REP": "Reset Processor Status Bits",
+	"RLA": "Rotate Left then AND",
+	"RMB": "Reset Memory Bit",
+	"ROL": "Rotate Left",
+	"ROR": "Rotate Right",
+	"RRA": "Rotate Right then Add with Carry",
+	"RTI": "Return from Interrupt",
+	"RTL": "Return from Subroutine Long",
+	"RTS": "Return from Subroutine",
+	"SAX": "Store Accumulator AND X",
+	"SBC": "Subtract with Carry",
+	"SBX": "Subtract from Accumulator AND X into X",
+	"SEC": "Set Carry Flag",
+	"SED": "Set Decimal Flag",
+	"SEI": "Set Interrupt Disable",
+	"SEP": "Set Processor Status Bits",
+	"SHA": "Store Accumulator AND X AND High Byte (unstable)",
+	"SHX": "Store X AND High Byte (unstable)",
+	"SHY": "Store Y AND High Byte (unstable)",
+	"SLO": "Shift Left then OR",
+	"SMB": "Set Memory Bit",
+	"SRE": "Shift Right then Exclusive OR",
+	"STA": "Store Accumulator",
+	"STP": "Stop the Clock",
+	"STX": "Store X Register",
+	"STY": "Store Y Register",
+	"STZ": "Store Zero",
+	"TAS": "Transfer Accumulator AND X to Stack Pointer and store it AND High Byte (unstable)",
+	"TAX": "Transfer Accumulator to X",
+	"TAY": "Transfer Accumulator to Y",
+	"TCD": "Transfer 16 bit Accumulator to Direct Page Register",
+	"TCS": "Transfer 16 bit Accumulator to Stack Pointer",
+	"TDC": "Transfer Direct Page Register to 16 bit Accumulator",
+	"TRB": "Test and Reset Bits",
+	"TSB": "Test and Set Bits",
+	"TSC": "Transfer Stack Pointer to 16 bit Accumulator",
+	"TSX": "Transfer Stack Pointer to X",
+	"TXA": "Transfer X to Accumulator",
+	"TXS": "Transfer X to Stack Pointer",
+	"TXY": "Transfer X to Y",
+	"TYA": "Transfer Y to Accumulator",
+	"TYX": "Transfer Y to X",
+	"WAI": "Wait for Interrupt",
+	"WDM": "Reserved for Future Expansion",
+	"XBA": "Exchange B and A Accumulator",
+	"XCE": "Exchange Carry and Emulation Flags",
+}
+
+// description returns the description of a mnemonic. The Rockwell bit instructions carry
+// the bit number in their mnemonic (e.g. RMB3), they share the description of the family.
+func description(mnemonic string) (string, bool) {
+	if d, ok := descriptions[mnemonic]; ok {
+		return d, true
+	}
+	family, bit := mnemonic[:len(mnemonic)-1], mnemonic[len(mnemonic)-1:]
+	if d, ok := descriptions[family]; ok && strings.Contains("01234567", bit) {
+		return d + " " + bit, true
+	}
+	return "", false
+}
+
+// modes maps an AddressingMode to the suffix of the Instruction constant and the instruction length in bytes.
+var modes = map[string]struct {
+	suffix string
+	bytes  int
+}{
+	"Implied":         {"", 1},
+	"Accumulator":     {"_A", 1},
+	"Immediate":       {"_I", 2},
+	"ZeroPage":        {"_Z", 2},
+	"ZeroPageX":       {"_ZX", 2},
+	"ZeroPageY":       {"_ZY", 2},
+	"Absolute":        {"_ABS", 3},
+	"AbsoluteX":       {"_ABSX", 3},
+	"AbsoluteY":       {"_ABSY", 3},
+	"Indirect":        {"_IND", 3},
+	"IndexedIndirect": {"_INDX", 2},
+	"IndirectIndexed": {"_INDY", 2},
+	"Relative":        {"", 2},
+
+	"ZeroPageIndirect":        {"_ZIND", 2},
+	"AbsoluteIndexedIndirect": {"_INDABSX", 3},
+	"ZeroPageRelative":        {"", 3},
+
+	"StackRelative":          {"_SR", 2},
+	"StackRelativeIndirectY": {"_SRINDY", 2},
+	"ZeroPageIndirectLong":   {"_ZINDL", 2},
+	"ZeroPageIndirectLongY":  {"_ZINDLY", 2},
+	"AbsoluteLong":           {"_ABSL", 4},
+	"AbsoluteLongX":          {"_ABSLX", 4},
+	"AbsoluteIndirectLong":   {"_INDL", 3},
+	"RelativeLong":           {"", 3},
+	"BlockMove":              {"", 3},
+}
+
+type entry struct {
+	opcode   int
+	name     string
+	mnemonic string
+	mode     string
+	bytes    int
+	cycles   int
+	handler  string
+}
+
+// opcodeSet is one of the generated opcode tables
+type opcodeSet struct {
+	// variable is the name of the generated [256]Opcode table
+	variable string
+	comment  string
+	entries  []entry
+	// handlers is the name of a separate handler table for the CPU type below,
+	// if it is empty the handlers are SixFiveOTwo methods stored in the Opcode entries
+	handlers string
+	cpu      string
+	memory   string
+	operand  string
+	// core are the SixFiveOTwo opcodes of a set with separate handlers, the entries with the same mnemonic
+	// and mode store the SixFiveOTwo handler in the Opcode entry as well
+	core []entry
+}
+
+func main() {
+	// Instruction names are shared by all sets, opcodes only have to be unique inside a set
+	names := map[string]int{}
+	nmos := parse(table, names)
+	cmos := overlay(nmos, parse(cmosTable, names))
+	sets := []opcodeSet{
+		{variable: "nmosOpcodes", comment: "holds the official instruction set of the NMOS 6502.", entries: nmos},
+		{variable: "undocumentedOpcodes", comment: "holds the undocumented opcodes of the NMOS 6502, see SixFiveOTwo.UndocumentedOpcodes.", entries: parse(undocumentedTable, names)},
+		{variable: "cmosOpcodes", comment: "holds the instruction set of the WDC 65C02.", entries: cmos},
+		{
+			variable: "w65816Opcodes", comment: "holds the instruction set of the WDC 65816.", entries: overlay(cmos, parse(w65816Table, names)),
+			handlers: "w65816Handlers", cpu: "SixFiveEightSixteen", memory: "Memory24", operand: "longOperand", core: cmos,
+		},
+	}
+
+	write("instructions.go", instructions(sets))
+	write("instruction_string.go", instructionString(sets))
+	write("opcode_table.go", opcodeTable(sets))
+}
+
+// parse reads an opcode table. An instruction name may only be used again for the same opcode,
+// the Instruction constant is shared then.
+func parse(src string, names map[string]int) []entry {
+	var entries []entry
+	seen := map[int]string{}
+
+	scanner := bufio.NewScanner(strings.NewReader(src))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 5 && len(fields) != 6 {
+			log.Fatalf("line %d: expected 5 or 6 columns, got %d", line, len(fields))
+		}
+		opcode, err := strconv.ParseUint(fields[0], 0, 8)
+		if err != nil {
+			log.Fatalf("line %d: bad opcode %q: %v", line, fields[0], err)
+		}
+		mode, ok := modes[fields[2]]
+		if !ok {
+			log.Fatalf("line %d: unknown addressing mode %q", line, fields[2])
+		}
+		if _, ok := description(fields[1]); !ok {
+			log.Fatalf("line %d: no description for mnemonic %q", line, fields[1])
+		}
+		cycles, err := strconv.Atoi(fields[3])
+		if err != nil {
+			log.Fatalf("line %d: bad cycle count %q: %v", line, fields[3], err)
+		}
+
+		e := entry{
+			opcode:   int(opcode),
+			name:     fields[1] + mode.suffix,
+			mnemonic: fields[1],
+			mode:     fields[2],
+			bytes:    mode.bytes,
+			cycles:   cycles,
+			handler:  fields[4],
+		}
+		if len(fields) == 6 {
+			e.name = fields[5]
+		}
+		if other, ok := seen[e.opcode]; ok {
+			log.Fatalf("line %d: opcode %#02x already used by %s", line, e.opcode, other)
+		}
+		if other, ok := names[e.name]; ok && other != e.opcode {
+			log.Fatalf("line %d: instruction name %s already used for opcode %#02x", line, e.name, other)
+		}
+		seen[e.opcode] = e.name
+		names[e.name] = e.opcode
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// overlay returns the base entries with the entries of the same opcode replaced by the changes.
+func overlay(base, changes []entry) []entry {
+	changed := map[int]bool{}
+	for _, e := range changes {
+		changed[e.opcode] = true
+	}
+	entries := append([]entry(nil), changes...)
+	for _, e := range base {
+		if !changed[e.opcode] {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func header(buf *bytes.Buffer) {
+	fmt.Fprintln(buf, `// Code generated by "go run gen_opcodes.go"; DO NOT EDIT.`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package computer")
+	fmt.Fprintln(buf)
+}
+
+func instructions(sets []opcodeSet) []byte {
+	buf := &bytes.Buffer{}
+	header(buf)
+	fmt.Fprintln(buf, "// Instruction represents a 6502 CPU instruction")
+	fmt.Fprintln(buf, "type Instruction uint8")
+	emitted := map[string]bool{}
+	for _, set := range sets {
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "// Instructions of %s\n", set.variable)
+		fmt.Fprintln(buf, "//")
+		fmt.Fprintln(buf, "//goland:noinspection ALL")
+		fmt.Fprintln(buf, "const (")
+		mnemonic := ""
+		for _, e := range set.entries {
+			if emitted[e.name] {
+				continue
+			}
+			emitted[e.name] = true
+			if e.mnemonic != mnemonic {
+				if mnemonic != "" {
+					fmt.Fprintln(buf)
+				}
+				mnemonic = e.mnemonic
+				d, _ := description(e.mnemonic)
+				fmt.Fprintf(buf, "\t// %s - %s\n", e.mnemonic, d)
+			}
+			fmt.Fprintf(buf, "\t%s Instruction = %#02X // %s\n", e.name, e.opcode, e.mode)
+		}
+		fmt.Fprintln(buf, ")")
+	}
+	return buf.Bytes()
+}
+
+// sortedByOpcode returns the entries of the set ordered by opcode.
+func sortedByOpcode(set opcodeSet) []entry {
+	sorted := append([]entry(nil), set.entries...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].opcode < sorted[j].opcode })
+	return sorted
+}
+
+func instructionString(sets []opcodeSet) []byte {
+	// An opcode is named after the first set that defines it
+	named := map[int]bool{}
+
+	buf := &bytes.Buffer{}
+	header(buf)
+	fmt.Fprintln(buf, `import "fmt"`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "var instructionNames = [256]string{")
+	for _, set := range sets {
+		for _, e := range sortedByOpcode(set) {
+			if named[e.opcode] {
+				continue
+			}
+			named[e.opcode] = true
+			fmt.Fprintf(buf, "\t%s: %q,\n", e.name, e.name)
+		}
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "func (i Instruction) String() string {")
+	fmt.Fprintln(buf, "\tif name := instructionNames[i]; name != \"\" {")
+	fmt.Fprintln(buf, "\t\treturn name")
+	fmt.Fprintln(buf, "\t}")
+	fmt.Fprintln(buf, "\treturn \"Instruction(\" + fmt.Sprintf(\"%#02X\", uint8(i)) + \")\"")
+	fmt.Fprintln(buf, "}")
+	return buf.Bytes()
+}
+
+func opcodeTable(sets []opcodeSet) []byte {
+	buf := &bytes.Buffer{}
+	header(buf)
+	for i, set := range sets {
+		if i > 0 {
+			fmt.Fprintln(buf)
+		}
+		fmt.Fprintf(buf, "// %s %s\n", set.variable, set.comment)
+		fmt.Fprintf(buf, "var %s = [256]Opcode{\n", set.variable)
+		for _, e := range sortedByOpcode(set) {
+			fmt.Fprintf(buf, "\t%s: {Mnemonic: %q, Mode: %s, Bytes: %d, Cycles: %d", e.name, e.mnemonic, e.mode, e.bytes, e.cycles)
+			if c, ok := coreEntry(set, e); ok {
+				fmt.Fprintf(buf, ", execute: %s", handler(opcodeSet{cpu: "SixFiveOTwo", memory: "Memory", operand: "operand"}, c))
+			}
+			fmt.Fprintln(buf, "},")
+		}
+		fmt.Fprintln(buf, "}")
+		if set.handlers == "" {
+			continue
+		}
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "// %s holds the handlers of %s.\n", set.handlers, set.variable)
+		fmt.Fprintf(buf, "var %s = [256]func(cpu *%s, mem %s, op %s){\n", set.handlers, set.cpu, set.memory, set.operand)
+		for _, e := range sortedByOpcode(set) {
+			if e.handler != "-" {
+				fmt.Fprintf(buf, "\t%s: %s,\n", e.name, handler(set, e))
+			}
+		}
+		fmt.Fprintln(buf, "}")
+	}
+	return buf.Bytes()
+}
+
+// coreEntry returns the entry whose SixFiveOTwo handler is stored in the Opcode entry of e, see opcodeSet.core.
+func coreEntry(set opcodeSet, e entry) (entry, bool) {
+	if set.handlers == "" {
+		return e, e.handler != "-"
+	}
+	for _, c := range set.core {
+		if c.opcode == e.opcode && c.mnemonic == e.mnemonic && c.mode == e.mode && c.handler != "-" {
+			return c, true
+		}
+	}
+	return entry{}, false
+}
+
+// handler returns the Go expression of the handler of an entry, a method value or a closure passing the constant arguments.
+func handler(set opcodeSet, e entry) string {
+	if name, args, ok := strings.Cut(e.handler, "("); ok {
+		return fmt.Sprintf("func(cpu *%s, mem %s, op %s) { cpu.%s(mem, op, %s }", set.cpu, set.memory, set.operand, name, args)
+	}
+	return fmt.Sprintf("(*%s).%s", set.cpu, e.handler)
+}
+
+func write(name string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting %s: %v\n%s", name, err, src)
+	}
+	if err := os.WriteFile(name, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,53 @@
+package computer
+
+// inc INC - Increment Memory
+//
+// Adds one to the value held at a specified memory location setting the zero and negative flags as appropriate.
+// The 65C02 can increment the accumulator as well (INC A).
+func (cpu *SixFiveOTwo) inc(mem Memory, op operand) {
+	cpu.readModifyWrite(mem, op, incrementAccess, func(value Word) Word {
+		value++
+		cpu.evaluateAndSetStatusFlags(value)
+		return value
+	})
+}
+
+// dec DEC - Decrement Memory
+//
+// Subtracts one from the value held at a specified memory location setting the zero and negative flags as appropriate.
+// The 65C02 can decrement the accumulator as well (DEC A).
+func (cpu *SixFiveOTwo) dec(mem Memory, op operand) {
+	cpu.readModifyWrite(mem, op, incrementAccess, func(value Word) Word {
+		value--
+		cpu.evaluateAndSetStatusFlags(value)
+		return value
+	})
+}
+
+// inx INX - Increment X Register
+//
+// Adds one to the X register setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) inx(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterX, cpu.RegisterX+1)
+}
+
+// iny INY - Increment Y Register
+//
+// Adds one to the Y register setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) iny(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterY, cpu.RegisterY+1)
+}
+
+// dex DEX - Decrement X Register
+//
+// Subtracts one from the X register setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) dex(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterX, cpu.RegisterX-1)
+}
+
+// dey DEY - Decrement Y Register
+//
+// Subtracts one from the Y register setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) dey(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterY, cpu.RegisterY-1)
+}
@@ -1,43 +1,271 @@
-// Code generated by "stringer -type=Instruction -trimprefix=_"; DO NOT EDIT.
+// Code generated by "go run gen_opcodes.go"; DO NOT EDIT.
 
 package computer
 
-import (
-	"fmt"
-)
+import "fmt"
 
-func _() {
-	// An "invalid array index" compiler error signifies that the constant values have changed.
-	// Re-run the stringer command to generate them again.
-	var x [1]struct{}
-	_ = x[LDA_I-169]
-	_ = x[LDX_I-162]
-	_ = x[ADC_ZX-117]
-	_ = x[JMP_ABS-76]
-	_ = x[JMP_IND-108]
+var instructionNames = [256]string{
+	BRK:      "BRK",
+	ORA_INDX: "ORA_INDX",
+	ORA_Z:    "ORA_Z",
+	ASL_Z:    "ASL_Z",
+	PHP:      "PHP",
+	ORA_I:    "ORA_I",
+	ASL_A:    "ASL_A",
+	ORA_ABS:  "ORA_ABS",
+	ASL_ABS:  "ASL_ABS",
+	BPL:      "BPL",
+	ORA_INDY: "ORA_INDY",
+	ORA_ZX:   "ORA_ZX",
+	ASL_ZX:   "ASL_ZX",
+	CLC:      "CLC",
+	ORA_ABSY: "ORA_ABSY",
+	ORA_ABSX: "ORA_ABSX",
+	ASL_ABSX: "ASL_ABSX",
+	JSR_ABS:  "JSR_ABS",
+	AND_INDX: "AND_INDX",
+	BIT_Z:    "BIT_Z",
+	AND_Z:    "AND_Z",
+	ROL_Z:    "ROL_Z",
+	PLP:      "PLP",
+	AND_I:    "AND_I",
+	ROL_A:    "ROL_A",
+	BIT_ABS:  "BIT_ABS",
+	AND_ABS:  "AND_ABS",
+	ROL_ABS:  "ROL_ABS",
+	BMI:      "BMI",
+	AND_INDY: "AND_INDY",
+	AND_ZX:   "AND_ZX",
+	ROL_ZX:   "ROL_ZX",
+	SEC:      "SEC",
+	AND_ABSY: "AND_ABSY",
+	AND_ABSX: "AND_ABSX",
+	ROL_ABSX: "ROL_ABSX",
+	RTI:      "RTI",
+	EOR_INDX: "EOR_INDX",
+	EOR_Z:    "EOR_Z",
+	LSR_Z:    "LSR_Z",
+	PHA:      "PHA",
+	EOR_I:    "EOR_I",
+	LSR_A:    "LSR_A",
+	JMP_ABS:  "JMP_ABS",
+	EOR_ABS:  "EOR_ABS",
+	LSR_ABS:  "LSR_ABS",
+	BVC:      "BVC",
+	EOR_INDY: "EOR_INDY",
+	EOR_ZX:   "EOR_ZX",
+	LSR_ZX:   "LSR_ZX",
+	CLI:      "CLI",
+	EOR_ABSY: "EOR_ABSY",
+	EOR_ABSX: "EOR_ABSX",
+	LSR_ABSX: "LSR_ABSX",
+	RTS:      "RTS",
+	ADC_INDX: "ADC_INDX",
+	ADC_Z:    "ADC_Z",
+	ROR_Z:    "ROR_Z",
+	PLA:      "PLA",
+	ADC_I:    "ADC_I",
+	ROR_A:    "ROR_A",
+	JMP_IND:  "JMP_IND",
+	ADC_ABS:  "ADC_ABS",
+	ROR_ABS:  "ROR_ABS",
+	BVS:      "BVS",
+	ADC_INDY: "ADC_INDY",
+	ADC_ZX:   "ADC_ZX",
+	ROR_ZX:   "ROR_ZX",
+	SEI:      "SEI",
+	ADC_ABSY: "ADC_ABSY",
+	ADC_ABSX: "ADC_ABSX",
+	ROR_ABSX: "ROR_ABSX",
+	STA_INDX: "STA_INDX",
+	STY_Z:    "STY_Z",
+	STA_Z:    "STA_Z",
+	STX_Z:    "STX_Z",
+	DEY:      "DEY",
+	TXA:      "TXA",
+	STY_ABS:  "STY_ABS",
+	STA_ABS:  "STA_ABS",
+	STX_ABS:  "STX_ABS",
+	BCC:      "BCC",
+	STA_INDY: "STA_INDY",
+	STY_ZX:   "STY_ZX",
+	STA_ZX:   "STA_ZX",
+	STX_ZY:   "STX_ZY",
+	TYA:      "TYA",
+	STA_ABSY: "STA_ABSY",
+	TXS:      "TXS",
+	STA_ABSX: "STA_ABSX",
+	LDY_I:    "LDY_I",
+	LDA_INDX: "LDA_INDX",
+	LDX_I:    "LDX_I",
+	LDY_Z:    "LDY_Z",
+	LDA_Z:    "LDA_Z",
+	LDX_Z:    "LDX_Z",
+	TAY:      "TAY",
+	LDA_I:    "LDA_I",
+	TAX:      "TAX",
+	LDY_ABS:  "LDY_ABS",
+	LDA_ABS:  "LDA_ABS",
+	LDX_ABS:  "LDX_ABS",
+	BCS:      "BCS",
+	LDA_INDY: "LDA_INDY",
+	LDY_ZX:   "LDY_ZX",
+	LDA_ZX:   "LDA_ZX",
+	LDX_ZY:   "LDX_ZY",
+	CLV:      "CLV",
+	LDA_ABSY: "LDA_ABSY",
+	TSX:      "TSX",
+	LDY_ABSX: "LDY_ABSX",
+	LDA_ABSX: "LDA_ABSX",
+	LDX_ABSY: "LDX_ABSY",
+	CPY_I:    "CPY_I",
+	CMP_INDX: "CMP_INDX",
+	CPY_Z:    "CPY_Z",
+	CMP_Z:    "CMP_Z",
+	DEC_Z:    "DEC_Z",
+	INY:      "INY",
+	CMP_I:    "CMP_I",
+	DEX:      "DEX",
+	CPY_ABS:  "CPY_ABS",
+	CMP_ABS:  "CMP_ABS",
+	DEC_ABS:  "DEC_ABS",
+	BNE:      "BNE",
+	CMP_INDY: "CMP_INDY",
+	CMP_ZX:   "CMP_ZX",
+	DEC_ZX:   "DEC_ZX",
+	CLD:      "CLD",
+	CMP_ABSY: "CMP_ABSY",
+	CMP_ABSX: "CMP_ABSX",
+	DEC_ABSX: "DEC_ABSX",
+	CPX_I:    "CPX_I",
+	SBC_INDX: "SBC_INDX",
+	CPX_Z:    "CPX_Z",
+	SBC_Z:    "SBC_Z",
+	INC_Z:    "INC_Z",
+	INX:      "INX",
+	SBC_I:    "SBC_I",
+	NOP:      "NOP",
+	CPX_ABS:  "CPX_ABS",
+	SBC_ABS:  "SBC_ABS",
+	INC_ABS:  "INC_ABS",
+	BEQ:      "BEQ",
+	SBC_INDY: "SBC_INDY",
+	SBC_ZX:   "SBC_ZX",
+	INC_ZX:   "INC_ZX",
+	SED:      "SED",
+	SBC_ABSY: "SBC_ABSY",
+	SBC_ABSX: "SBC_ABSX",
+	INC_ABSX: "INC_ABSX",
+	JAM_02:   "JAM_02",
+	SLO_INDX: "SLO_INDX",
+	NOP_04:   "NOP_04",
+	SLO_Z:    "SLO_Z",
+	ANC_I:    "ANC_I",
+	NOP_0C:   "NOP_0C",
+	SLO_ABS:  "SLO_ABS",
+	JAM_12:   "JAM_12",
+	SLO_INDY: "SLO_INDY",
+	NOP_14:   "NOP_14",
+	SLO_ZX:   "SLO_ZX",
+	NOP_1A:   "NOP_1A",
+	SLO_ABSY: "SLO_ABSY",
+	NOP_1C:   "NOP_1C",
+	SLO_ABSX: "SLO_ABSX",
+	JAM_22:   "JAM_22",
+	RLA_INDX: "RLA_INDX",
+	RLA_Z:    "RLA_Z",
+	ANC_2B:   "ANC_2B",
+	RLA_ABS:  "RLA_ABS",
+	JAM_32:   "JAM_32",
+	RLA_INDY: "RLA_INDY",
+	NOP_34:   "NOP_34",
+	RLA_ZX:   "RLA_ZX",
+	NOP_3A:   "NOP_3A",
+	RLA_ABSY: "RLA_ABSY",
+	NOP_3C:   "NOP_3C",
+	RLA_ABSX: "RLA_ABSX",
+	JAM_42:   "JAM_42",
+	SRE_INDX: "SRE_INDX",
+	NOP_44:   "NOP_44",
+	SRE_Z:    "SRE_Z",
+	ALR_I:    "ALR_I",
+	SRE_ABS:  "SRE_ABS",
+	JAM_52:   "JAM_52",
+	SRE_INDY: "SRE_INDY",
+	NOP_54:   "NOP_54",
+	SRE_ZX:   "SRE_ZX",
+	NOP_5A:   "NOP_5A",
+	SRE_ABSY: "SRE_ABSY",
+	NOP_5C:   "NOP_5C",
+	SRE_ABSX: "SRE_ABSX",
+	JAM_62:   "JAM_62",
+	RRA_INDX: "RRA_INDX",
+	NOP_64:   "NOP_64",
+	RRA_Z:    "RRA_Z",
+	ARR_I:    "ARR_I",
+	RRA_ABS:  "RRA_ABS",
+	JAM_72:   "JAM_72",
+	RRA_INDY: "RRA_INDY",
+	NOP_74:   "NOP_74",
+	RRA_ZX:   "RRA_ZX",
+	NOP_7A:   "NOP_7A",
+	RRA_ABSY: "RRA_ABSY",
+	NOP_7C:   "NOP_7C",
+	RRA_ABSX: "RRA_ABSX",
+	NOP_80:   "NOP_80",
+	NOP_82:   "NOP_82",
+	SAX_INDX: "SAX_INDX",
+	SAX_Z:    "SAX_Z",
+	NOP_89:   "NOP_89",
+	ANE_I:    "ANE_I",
+	SAX_ABS:  "SAX_ABS",
+	JAM_92:   "JAM_92",
+	SHA_INDY: "SHA_INDY",
+	SAX_ZY:   "SAX_ZY",
+	TAS_ABSY: "TAS_ABSY",
+	SHY_ABSX: "SHY_ABSX",
+	SHX_ABSY: "SHX_ABSY",
+	SHA_ABSY: "SHA_ABSY",
+	LAX_INDX: "LAX_INDX",
+	LAX_Z:    "LAX_Z",
+	LXA_I:    "LXA_I",
+	LAX_ABS:  "LAX_ABS",
+	JAM_B2:   "JAM_B2",
+	LAX_INDY: "LAX_INDY",
+	LAX_ZY:   "LAX_ZY",
+	LAS_ABSY: "LAS_ABSY",
+	LAX_ABSY: "LAX_ABSY",
+	NOP_C2:   "NOP_C2",
+	DCP_INDX: "DCP_INDX",
+	DCP_Z:    "DCP_Z",
+	SBX_I:    "SBX_I",
+	DCP_ABS:  "DCP_ABS",
+	JAM_D2:   "JAM_D2",
+	DCP_INDY: "DCP_INDY",
+	NOP_D4:   "NOP_D4",
+	DCP_ZX:   "DCP_ZX",
+	NOP_DA:   "NOP_DA",
+	DCP_ABSY: "DCP_ABSY",
+	NOP_DC:   "NOP_DC",
+	DCP_ABSX: "DCP_ABSX",
+	NOP_E2:   "NOP_E2",
+	ISC_INDX: "ISC_INDX",
+	ISC_Z:    "ISC_Z",
+	SBC_EB:   "SBC_EB",
+	ISC_ABS:  "ISC_ABS",
+	JAM_F2:   "JAM_F2",
+	ISC_INDY: "ISC_INDY",
+	NOP_F4:   "NOP_F4",
+	ISC_ZX:   "ISC_ZX",
+	NOP_FA:   "NOP_FA",
+	ISC_ABSY: "ISC_ABSY",
+	NOP_FC:   "NOP_FC",
+	ISC_ABSX: "ISC_ABSX",
 }
 
-const (
-	_Instruction_name_0 = "JMP_ABS"
-	_Instruction_name_1 = "JMP_IND"
-	_Instruction_name_2 = "ADC_ZX"
-	_Instruction_name_3 = "LDX_I"
-	_Instruction_name_4 = "LDA_I"
-)
-
 func (i Instruction) String() string {
-	switch {
-	case i == 76:
-		return _Instruction_name_0
-	case i == 108:
-		return _Instruction_name_1
-	case i == 117:
-		return _Instruction_name_2
-	case i == 162:
-		return _Instruction_name_3
-	case i == 169:
-		return _Instruction_name_4
-	default:
-		return "Instruction(" + fmt.Sprintf("%#02X", uint8(i)) + ")"
+	if name := instructionNames[i]; name != "" {
+		return name
 	}
+	return "Instruction(" + fmt.Sprintf("%#02X", uint8(i)) + ")"
 }
@@ -1,37 +1,789 @@
-//go:generate stringer -type=Instruction
+// Code generated by "go run gen_opcodes.go"; DO NOT EDIT.
 
 package computer
 
 // Instruction represents a 6502 CPU instruction
 type Instruction uint8
 
+// Instructions of nmosOpcodes
+//
 //goland:noinspection ALL
 const (
-	// Load Accumulation
-	// LDA_I Load Immediate
-	LDA_I Instruction = 0xA9
-	// LDA_Z Load thru Zero Page
-	LDA_Z Instruction = 0xA5
+	// ADC - Add with Carry
+	ADC_I    Instruction = 0x69 // Immediate
+	ADC_Z    Instruction = 0x65 // ZeroPage
+	ADC_ZX   Instruction = 0x75 // ZeroPageX
+	ADC_ABS  Instruction = 0x6D // Absolute
+	ADC_ABSX Instruction = 0x7D // AbsoluteX
+	ADC_ABSY Instruction = 0x79 // AbsoluteY
+	ADC_INDX Instruction = 0x61 // IndexedIndirect
+	ADC_INDY Instruction = 0x71 // IndirectIndexed
 
-	// Load X-Register
-	LDX_I Instruction = 0xA2
+	// AND - Logical AND
+	AND_I    Instruction = 0x29 // Immediate
+	AND_Z    Instruction = 0x25 // ZeroPage
+	AND_ZX   Instruction = 0x35 // ZeroPageX
+	AND_ABS  Instruction = 0x2D // Absolute
+	AND_ABSX Instruction = 0x3D // AbsoluteX
+	AND_ABSY Instruction = 0x39 // AbsoluteY
+	AND_INDX Instruction = 0x21 // IndexedIndirect
+	AND_INDY Instruction = 0x31 // IndirectIndexed
 
-	// ADC - Add with Carry
-	ADC_ZX Instruction = 0x75 // Zero Page,X
+	// ASL - Arithmetic Shift Left
+	ASL_A    Instruction = 0x0A // Accumulator
+	ASL_Z    Instruction = 0x06 // ZeroPage
+	ASL_ZX   Instruction = 0x16 // ZeroPageX
+	ASL_ABS  Instruction = 0x0E // Absolute
+	ASL_ABSX Instruction = 0x1E // AbsoluteX
+
+	// BCC - Branch if Carry Clear
+	BCC Instruction = 0x90 // Relative
+
+	// BCS - Branch if Carry Set
+	BCS Instruction = 0xB0 // Relative
+
+	// BEQ - Branch if Equal
+	BEQ Instruction = 0xF0 // Relative
+
+	// BIT - Bit Test
+	BIT_Z   Instruction = 0x24 // ZeroPage
+	BIT_ABS Instruction = 0x2C // Absolute
+
+	// BMI - Branch if Minus
+	BMI Instruction = 0x30 // Relative
+
+	// BNE - Branch if Not Equal
+	BNE Instruction = 0xD0 // Relative
+
+	// BPL - Branch if Positive
+	BPL Instruction = 0x10 // Relative
+
+	// BRK - Force Interrupt
+	BRK Instruction = 0x00 // Implied
+
+	// BVC - Branch if Overflow Clear
+	BVC Instruction = 0x50 // Relative
+
+	// BVS - Branch if Overflow Set
+	BVS Instruction = 0x70 // Relative
+
+	// CLC - Clear Carry Flag
+	CLC Instruction = 0x18 // Implied
+
+	// CLD - Clear Decimal Mode
+	CLD Instruction = 0xD8 // Implied
+
+	// CLI - Clear Interrupt Disable
+	CLI Instruction = 0x58 // Implied
+
+	// CLV - Clear Overflow Flag
+	CLV Instruction = 0xB8 // Implied
+
+	// CMP - Compare
+	CMP_I    Instruction = 0xC9 // Immediate
+	CMP_Z    Instruction = 0xC5 // ZeroPage
+	CMP_ZX   Instruction = 0xD5 // ZeroPageX
+	CMP_ABS  Instruction = 0xCD // Absolute
+	CMP_ABSX Instruction = 0xDD // AbsoluteX
+	CMP_ABSY Instruction = 0xD9 // AbsoluteY
+	CMP_INDX Instruction = 0xC1 // IndexedIndirect
+	CMP_INDY Instruction = 0xD1 // IndirectIndexed
+
+	// CPX - Compare X Register
+	CPX_I   Instruction = 0xE0 // Immediate
+	CPX_Z   Instruction = 0xE4 // ZeroPage
+	CPX_ABS Instruction = 0xEC // Absolute
+
+	// CPY - Compare Y Register
+	CPY_I   Instruction = 0xC0 // Immediate
+	CPY_Z   Instruction = 0xC4 // ZeroPage
+	CPY_ABS Instruction = 0xCC // Absolute
+
+	// DEC - Decrement Memory
+	DEC_Z    Instruction = 0xC6 // ZeroPage
+	DEC_ZX   Instruction = 0xD6 // ZeroPageX
+	DEC_ABS  Instruction = 0xCE // Absolute
+	DEC_ABSX Instruction = 0xDE // AbsoluteX
+
+	// DEX - Decrement X Register
+	DEX Instruction = 0xCA // Implied
+
+	// DEY - Decrement Y Register
+	DEY Instruction = 0x88 // Implied
+
+	// EOR - Exclusive OR
+	EOR_I    Instruction = 0x49 // Immediate
+	EOR_Z    Instruction = 0x45 // ZeroPage
+	EOR_ZX   Instruction = 0x55 // ZeroPageX
+	EOR_ABS  Instruction = 0x4D // Absolute
+	EOR_ABSX Instruction = 0x5D // AbsoluteX
+	EOR_ABSY Instruction = 0x59 // AbsoluteY
+	EOR_INDX Instruction = 0x41 // IndexedIndirect
+	EOR_INDY Instruction = 0x51 // IndirectIndexed
+
+	// INC - Increment Memory
+	INC_Z    Instruction = 0xE6 // ZeroPage
+	INC_ZX   Instruction = 0xF6 // ZeroPageX
+	INC_ABS  Instruction = 0xEE // Absolute
+	INC_ABSX Instruction = 0xFE // AbsoluteX
+
+	// INX - Increment X Register
+	INX Instruction = 0xE8 // Implied
+
+	// INY - Increment Y Register
+	INY Instruction = 0xC8 // Implied
 
 	// JMP - Jump
 	JMP_ABS Instruction = 0x4C // Absolute
 	JMP_IND Instruction = 0x6C // Indirect
+
+	// JSR - Jump to Subroutine
+	JSR_ABS Instruction = 0x20 // Absolute
+
+	// LDA - Load Accumulator
+	LDA_I    Instruction = 0xA9 // Immediate
+	LDA_Z    Instruction = 0xA5 // ZeroPage
+	LDA_ZX   Instruction = 0xB5 // ZeroPageX
+	LDA_ABS  Instruction = 0xAD // Absolute
+	LDA_ABSX Instruction = 0xBD // AbsoluteX
+	LDA_ABSY Instruction = 0xB9 // AbsoluteY
+	LDA_INDX Instruction = 0xA1 // IndexedIndirect
+	LDA_INDY Instruction = 0xB1 // IndirectIndexed
+
+	// LDX - Load X Register
+	LDX_I    Instruction = 0xA2 // Immediate
+	LDX_Z    Instruction = 0xA6 // ZeroPage
+	LDX_ZY   Instruction = 0xB6 // ZeroPageY
+	LDX_ABS  Instruction = 0xAE // Absolute
+	LDX_ABSY Instruction = 0xBE // AbsoluteY
+
+	// LDY - Load Y Register
+	LDY_I    Instruction = 0xA0 // Immediate
+	LDY_Z    Instruction = 0xA4 // ZeroPage
+	LDY_ZX   Instruction = 0xB4 // ZeroPageX
+	LDY_ABS  Instruction = 0xAC // Absolute
+	LDY_ABSX Instruction = 0xBC // AbsoluteX
+
+	// LSR - Logical Shift Right
+	LSR_A    Instruction = 0x4A // Accumulator
+	LSR_Z    Instruction = 0x46 // ZeroPage
+	LSR_ZX   Instruction = 0x56 // ZeroPageX
+	LSR_ABS  Instruction = 0x4E // Absolute
+	LSR_ABSX Instruction = 0x5E // AbsoluteX
+
+	// NOP - No Operation
+	NOP Instruction = 0xEA // Implied
+
+	// ORA - Logical Inclusive OR
+	ORA_I    Instruction = 0x09 // Immediate
+	ORA_Z    Instruction = 0x05 // ZeroPage
+	ORA_ZX   Instruction = 0x15 // ZeroPageX
+	ORA_ABS  Instruction = 0x0D // Absolute
+	ORA_ABSX Instruction = 0x1D // AbsoluteX
+	ORA_ABSY Instruction = 0x19 // AbsoluteY
+	ORA_INDX Instruction = 0x01 // IndexedIndirect
+	ORA_INDY Instruction = 0x11 // IndirectIndexed
+
+	// PHA - Push Accumulator
+	PHA Instruction = 0x48 // Implied
+
+	// PHP - Push Processor Status
+	PHP Instruction = 0x08 // Implied
+
+	// PLA - Pull Accumulator
+	PLA Instruction = 0x68 // Implied
+
+	// PLP - Pull Processor Status
+	PLP Instruction = 0x28 // Implied
+
+	// ROL - Rotate Left
+	ROL_A    Instruction = 0x2A // Accumulator
+	ROL_Z    Instruction = 0x26 // ZeroPage
+	ROL_ZX   Instruction = 0x36 // ZeroPageX
+	ROL_ABS  Instruction = 0x2E // Absolute
+	ROL_ABSX Instruction = 0x3E // AbsoluteX
+
+	// ROR - Rotate Right
+	ROR_A    Instruction = 0x6A // Accumulator
+	ROR_Z    Instruction = 0x66 // ZeroPage
+	ROR_ZX   Instruction = 0x76 // ZeroPageX
+	ROR_ABS  Instruction = 0x6E // Absolute
+	ROR_ABSX Instruction = 0x7E // AbsoluteX
+
+	// RTI - Return from Interrupt
+	RTI Instruction = 0x40 // Implied
+
+	// RTS - Return from Subroutine
+	RTS Instruction = 0x60 // Implied
+
+	// SBC - Subtract with Carry
+	SBC_I    Instruction = 0xE9 // Immediate
+	SBC_Z    Instruction = 0xE5 // ZeroPage
+	SBC_ZX   Instruction = 0xF5 // ZeroPageX
+	SBC_ABS  Instruction = 0xED // Absolute
+	SBC_ABSX Instruction = 0xFD // AbsoluteX
+	SBC_ABSY Instruction = 0xF9 // AbsoluteY
+	SBC_INDX Instruction = 0xE1 // IndexedIndirect
+	SBC_INDY Instruction = 0xF1 // IndirectIndexed
+
+	// SEC - Set Carry Flag
+	SEC Instruction = 0x38 // Implied
+
+	// SED - Set Decimal Flag
+	SED Instruction = 0xF8 // Implied
+
+	// SEI - Set Interrupt Disable
+	SEI Instruction = 0x78 // Implied
+
+	// STA - Store Accumulator
+	STA_Z    Instruction = 0x85 // ZeroPage
+	STA_ZX   Instruction = 0x95 // ZeroPageX
+	STA_ABS  Instruction = 0x8D // Absolute
+	STA_ABSX Instruction = 0x9D // AbsoluteX
+	STA_ABSY Instruction = 0x99 // AbsoluteY
+	STA_INDX Instruction = 0x81 // IndexedIndirect
+	STA_INDY Instruction = 0x91 // IndirectIndexed
+
+	// STX - Store X Register
+	STX_Z   Instruction = 0x86 // ZeroPage
+	STX_ZY  Instruction = 0x96 // ZeroPageY
+	STX_ABS Instruction = 0x8E // Absolute
+
+	// STY - Store Y Register
+	STY_Z   Instruction = 0x84 // ZeroPage
+	STY_ZX  Instruction = 0x94 // ZeroPageX
+	STY_ABS Instruction = 0x8C // Absolute
+
+	// TAX - Transfer Accumulator to X
+	TAX Instruction = 0xAA // Implied
+
+	// TAY - Transfer Accumulator to Y
+	TAY Instruction = 0xA8 // Implied
+
+	// TSX - Transfer Stack Pointer to X
+	TSX Instruction = 0xBA // Implied
+
+	// TXA - Transfer X to Accumulator
+	TXA Instruction = 0x8A // Implied
+
+	// TXS - Transfer X to Stack Pointer
+	TXS Instruction = 0x9A // Implied
+
+	// TYA - Transfer Y to Accumulator
+	TYA Instruction = 0x98 // Implied
+)
+
+// Instructions of undocumentedOpcodes
+//
+//goland:noinspection ALL
+const (
+	// ALR - AND then Logical Shift Right
+	ALR_I Instruction = 0x4B // Immediate
+
+	// ANC - AND then copy Negative to Carry
+	ANC_I  Instruction = 0x0B // Immediate
+	ANC_2B Instruction = 0x2B // Immediate
+
+	// ANE - AND X and Immediate into Accumulator (unstable)
+	ANE_I Instruction = 0x8B // Immediate
+
+	// ARR - AND then Rotate Right
+	ARR_I Instruction = 0x6B // Immediate
+
+	// DCP - Decrement then Compare
+	DCP_Z    Instruction = 0xC7 // ZeroPage
+	DCP_ZX   Instruction = 0xD7 // ZeroPageX
+	DCP_ABS  Instruction = 0xCF // Absolute
+	DCP_ABSX Instruction = 0xDF // AbsoluteX
+	DCP_ABSY Instruction = 0xDB // AbsoluteY
+	DCP_INDX Instruction = 0xC3 // IndexedIndirect
+	DCP_INDY Instruction = 0xD3 // IndirectIndexed
+
+	// ISC - Increment then Subtract with Carry
+	ISC_Z    Instruction = 0xE7 // ZeroPage
+	ISC_ZX   Instruction = 0xF7 // ZeroPageX
+	ISC_ABS  Instruction = 0xEF // Absolute
+	ISC_ABSX Instruction = 0xFF // AbsoluteX
+	ISC_ABSY Instruction = 0xFB // AbsoluteY
+	ISC_INDX Instruction = 0xE3 // IndexedIndirect
+	ISC_INDY Instruction = 0xF3 // IndirectIndexed
+
+	// JAM - Halt the CPU
+	JAM_02 Instruction = 0x02 // Implied
+	JAM_12 Instruction = 0x12 // Implied
+	JAM_22 Instruction = 0x22 // Implied
+	JAM_32 Instruction = 0x32 // Implied
+	JAM_42 Instruction = 0x42 // Implied
+	JAM_52 Instruction = 0x52 // Implied
+	JAM_62 Instruction = 0x62 // Implied
+	JAM_72 Instruction = 0x72 // Implied
+	JAM_92 Instruction = 0x92 // Implied
+	JAM_B2 Instruction = 0xB2 // Implied
+	JAM_D2 Instruction = 0xD2 // Implied
+	JAM_F2 Instruction = 0xF2 // Implied
+
+	// LAS - AND Stack Pointer into Accumulator, X and Stack Pointer
+	LAS_ABSY Instruction = 0xBB // AbsoluteY
+
+	// LAX - Load Accumulator and X Register
+	LAX_Z    Instruction = 0xA7 // ZeroPage
+	LAX_ZY   Instruction = 0xB7 // ZeroPageY
+	LAX_ABS  Instruction = 0xAF // Absolute
+	LAX_ABSY Instruction = 0xBF // AbsoluteY
+	LAX_INDX Instruction = 0xA3 // IndexedIndirect
+	LAX_INDY Instruction = 0xB3 // IndirectIndexed
+
+	// LXA - AND Immediate into Accumulator and X (unstable)
+	LXA_I Instruction = 0xAB // Immediate
+
+	// NOP - No Operation
+	NOP_1A Instruction = 0x1A // Implied
+	NOP_3A Instruction = 0x3A // Implied
+	NOP_5A Instruction = 0x5A // Implied
+	NOP_7A Instruction = 0x7A // Implied
+	NOP_DA Instruction = 0xDA // Implied
+	NOP_FA Instruction = 0xFA // Implied
+	NOP_80 Instruction = 0x80 // Immediate
+	NOP_82 Instruction = 0x82 // Immediate
+	NOP_89 Instruction = 0x89 // Immediate
+	NOP_C2 Instruction = 0xC2 // Immediate
+	NOP_E2 Instruction = 0xE2 // Immediate
+	NOP_04 Instruction = 0x04 // ZeroPage
+	NOP_44 Instruction = 0x44 // ZeroPage
+	NOP_64 Instruction = 0x64 // ZeroPage
+	NOP_14 Instruction = 0x14 // ZeroPageX
+	NOP_34 Instruction = 0x34 // ZeroPageX
+	NOP_54 Instruction = 0x54 // ZeroPageX
+	NOP_74 Instruction = 0x74 // ZeroPageX
+	NOP_D4 Instruction = 0xD4 // ZeroPageX
+	NOP_F4 Instruction = 0xF4 // ZeroPageX
+	NOP_0C Instruction = 0x0C // Absolute
+	NOP_1C Instruction = 0x1C // AbsoluteX
+	NOP_3C Instruction = 0x3C // AbsoluteX
+	NOP_5C Instruction = 0x5C // AbsoluteX
+	NOP_7C Instruction = 0x7C // AbsoluteX
+	NOP_DC Instruction = 0xDC // AbsoluteX
+	NOP_FC Instruction = 0xFC // AbsoluteX
+
+	// RLA - Rotate Left then AND
+	RLA_Z    Instruction = 0x27 // ZeroPage
+	RLA_ZX   Instruction = 0x37 // ZeroPageX
+	RLA_ABS  Instruction = 0x2F // Absolute
+	RLA_ABSX Instruction = 0x3F // AbsoluteX
+	RLA_ABSY Instruction = 0x3B // AbsoluteY
+	RLA_INDX Instruction = 0x23 // IndexedIndirect
+	RLA_INDY Instruction = 0x33 // IndirectIndexed
+
+	// RRA - Rotate Right then Add with Carry
+	RRA_Z    Instruction = 0x67 // ZeroPage
+	RRA_ZX   Instruction = 0x77 // ZeroPageX
+	RRA_ABS  Instruction = 0x6F // Absolute
+	RRA_ABSX Instruction = 0x7F // AbsoluteX
+	RRA_ABSY Instruction = 0x7B // AbsoluteY
+	RRA_INDX Instruction = 0x63 // IndexedIndirect
+	RRA_INDY Instruction = 0x73 // IndirectIndexed
+
+	// SAX - Store Accumulator AND X
+	SAX_Z    Instruction = 0x87 // ZeroPage
+	SAX_ZY   Instruction = 0x97 // ZeroPageY
+	SAX_ABS  Instruction = 0x8F // Absolute
+	SAX_INDX Instruction = 0x83 // IndexedIndirect
+
+	// SBC - Subtract with Carry
+	SBC_EB Instruction = 0xEB // Immediate
+
+	// SBX - Subtract from Accumulator AND X into X
+	SBX_I Instruction = 0xCB // Immediate
+
+	// SHA - Store Accumulator AND X AND High Byte (unstable)
+	SHA_ABSY Instruction = 0x9F // AbsoluteY
+	SHA_INDY Instruction = 0x93 // IndirectIndexed
+
+	// SHX - Store X AND High Byte (unstable)
+	SHX_ABSY Instruction = 0x9E // AbsoluteY
+
+	// SHY - Store Y AND High Byte (unstable)
+	SHY_ABSX Instruction = 0x9C // AbsoluteX
+
+	// SLO - Shift Left then OR
+	SLO_Z    Instruction = 0x07 // ZeroPage
+	SLO_ZX   Instruction = 0x17 // ZeroPageX
+	SLO_ABS  Instruction = 0x0F // Absolute
+	SLO_ABSX Instruction = 0x1F // AbsoluteX
+	SLO_ABSY Instruction = 0x1B // AbsoluteY
+	SLO_INDX Instruction = 0x03 // IndexedIndirect
+	SLO_INDY Instruction = 0x13 // IndirectIndexed
+
+	// SRE - Shift Right then Exclusive OR
+	SRE_Z    Instruction = 0x47 // ZeroPage
+	SRE_ZX   Instruction = 0x57 // ZeroPageX
+	SRE_ABS  Instruction = 0x4F // Absolute
+	SRE_ABSX Instruction = 0x5F // AbsoluteX
+	SRE_ABSY Instruction = 0x5B // AbsoluteY
+	SRE_INDX Instruction = 0x43 // IndexedIndirect
+	SRE_INDY Instruction = 0x53 // IndirectIndexed
+
+	// TAS - Transfer Accumulator AND X to Stack Pointer and store it AND High Byte (unstable)
+	TAS_ABSY Instruction = 0x9B // AbsoluteY
 )
 
-// Bit masks for processor status flags
+// Instructions of cmosOpcodes
+//
+//goland:noinspection ALL
 const (
-	bit7 Word = 0x80
-	bit6 Word = 0x40
-	bit5 Word = 0x20
-	bit4 Word = 0x10
-	bit3 Word = 0x8
-	bit2 Word = 0x4
-	bit1 Word = 0x2
-	bit0 Word = 0x1
+	// ADC - Add with Carry
+	ADC_ZIND Instruction = 0x72 // ZeroPageIndirect
+
+	// AND - Logical AND
+	AND_ZIND Instruction = 0x32 // ZeroPageIndirect
+
+	// BBR0 - Branch on Bit Reset 0
+	BBR0 Instruction = 0x0F // ZeroPageRelative
+
+	// BBR1 - Branch on Bit Reset 1
+	BBR1 Instruction = 0x1F // ZeroPageRelative
+
+	// BBR2 - Branch on Bit Reset 2
+	BBR2 Instruction = 0x2F // ZeroPageRelative
+
+	// BBR3 - Branch on Bit Reset 3
+	BBR3 Instruction = 0x3F // ZeroPageRelative
+
+	// BBR4 - Branch on Bit Reset 4
+	BBR4 Instruction = 0x4F // ZeroPageRelative
+
+	// BBR5 - Branch on Bit Reset 5
+	BBR5 Instruction = 0x5F // ZeroPageRelative
+
+	// BBR6 - Branch on Bit Reset 6
+	BBR6 Instruction = 0x6F // ZeroPageRelative
+
+	// BBR7 - Branch on Bit Reset 7
+	BBR7 Instruction = 0x7F // ZeroPageRelative
+
+	// BBS0 - Branch on Bit Set 0
+	BBS0 Instruction = 0x8F // ZeroPageRelative
+
+	// BBS1 - Branch on Bit Set 1
+	BBS1 Instruction = 0x9F // ZeroPageRelative
+
+	// BBS2 - Branch on Bit Set 2
+	BBS2 Instruction = 0xAF // ZeroPageRelative
+
+	// BBS3 - Branch on Bit Set 3
+	BBS3 Instruction = 0xBF // ZeroPageRelative
+
+	// BBS4 - Branch on Bit Set 4
+	BBS4 Instruction = 0xCF // ZeroPageRelative
+
+	// BBS5 - Branch on Bit Set 5
+	BBS5 Instruction = 0xDF // ZeroPageRelative
+
+	// BBS6 - Branch on Bit Set 6
+	BBS6 Instruction = 0xEF // ZeroPageRelative
+
+	// BBS7 - Branch on Bit Set 7
+	BBS7 Instruction = 0xFF // ZeroPageRelative
+
+	// BIT - Bit Test
+	BIT_I    Instruction = 0x89 // Immediate
+	BIT_ZX   Instruction = 0x34 // ZeroPageX
+	BIT_ABSX Instruction = 0x3C // AbsoluteX
+
+	// BRA - Branch Always
+	BRA Instruction = 0x80 // Relative
+
+	// CMP - Compare
+	CMP_ZIND Instruction = 0xD2 // ZeroPageIndirect
+
+	// DEC - Decrement Memory
+	DEC_A Instruction = 0x3A // Accumulator
+
+	// EOR - Exclusive OR
+	EOR_ZIND Instruction = 0x52 // ZeroPageIndirect
+
+	// INC - Increment Memory
+	INC_A Instruction = 0x1A // Accumulator
+
+	// JMP - Jump
+	JMP_INDABSX Instruction = 0x7C // AbsoluteIndexedIndirect
+
+	// LDA - Load Accumulator
+	LDA_ZIND Instruction = 0xB2 // ZeroPageIndirect
+
+	// NOP - No Operation
+	NOP_02 Instruction = 0x02 // Immediate
+	NOP_22 Instruction = 0x22 // Immediate
+	NOP_42 Instruction = 0x42 // Immediate
+	NOP_62 Instruction = 0x62 // Immediate
+	NOP_03 Instruction = 0x03 // Implied
+	NOP_0B Instruction = 0x0B // Implied
+	NOP_13 Instruction = 0x13 // Implied
+	NOP_1B Instruction = 0x1B // Implied
+	NOP_23 Instruction = 0x23 // Implied
+	NOP_2B Instruction = 0x2B // Implied
+	NOP_33 Instruction = 0x33 // Implied
+	NOP_3B Instruction = 0x3B // Implied
+	NOP_43 Instruction = 0x43 // Implied
+	NOP_4B Instruction = 0x4B // Implied
+	NOP_53 Instruction = 0x53 // Implied
+	NOP_5B Instruction = 0x5B // Implied
+	NOP_63 Instruction = 0x63 // Implied
+	NOP_6B Instruction = 0x6B // Implied
+	NOP_73 Instruction = 0x73 // Implied
+	NOP_7B Instruction = 0x7B // Implied
+	NOP_83 Instruction = 0x83 // Implied
+	NOP_8B Instruction = 0x8B // Implied
+	NOP_93 Instruction = 0x93 // Implied
+	NOP_9B Instruction = 0x9B // Implied
+	NOP_A3 Instruction = 0xA3 // Implied
+	NOP_AB Instruction = 0xAB // Implied
+	NOP_B3 Instruction = 0xB3 // Implied
+	NOP_BB Instruction = 0xBB // Implied
+	NOP_C3 Instruction = 0xC3 // Implied
+	NOP_D3 Instruction = 0xD3 // Implied
+	NOP_E3 Instruction = 0xE3 // Implied
+	NOP_EB Instruction = 0xEB // Implied
+	NOP_F3 Instruction = 0xF3 // Implied
+	NOP_FB Instruction = 0xFB // Implied
+
+	// ORA - Logical Inclusive OR
+	ORA_ZIND Instruction = 0x12 // ZeroPageIndirect
+
+	// PHX - Push X Register
+	PHX Instruction = 0xDA // Implied
+
+	// PHY - Push Y Register
+	PHY Instruction = 0x5A // Implied
+
+	// PLX - Pull X Register
+	PLX Instruction = 0xFA // Implied
+
+	// PLY - Pull Y Register
+	PLY Instruction = 0x7A // Implied
+
+	// RMB0 - Reset Memory Bit 0
+	RMB0_Z Instruction = 0x07 // ZeroPage
+
+	// RMB1 - Reset Memory Bit 1
+	RMB1_Z Instruction = 0x17 // ZeroPage
+
+	// RMB2 - Reset Memory Bit 2
+	RMB2_Z Instruction = 0x27 // ZeroPage
+
+	// RMB3 - Reset Memory Bit 3
+	RMB3_Z Instruction = 0x37 // ZeroPage
+
+	// RMB4 - Reset Memory Bit 4
+	RMB4_Z Instruction = 0x47 // ZeroPage
+
+	// RMB5 - Reset Memory Bit 5
+	RMB5_Z Instruction = 0x57 // ZeroPage
+
+	// RMB6 - Reset Memory Bit 6
+	RMB6_Z Instruction = 0x67 // ZeroPage
+
+	// RMB7 - Reset Memory Bit 7
+	RMB7_Z Instruction = 0x77 // ZeroPage
+
+	// SBC - Subtract with Carry
+	SBC_ZIND Instruction = 0xF2 // ZeroPageIndirect
+
+	// SMB0 - Set Memory Bit 0
+	SMB0_Z Instruction = 0x87 // ZeroPage
+
+	// SMB1 - Set Memory Bit 1
+	SMB1_Z Instruction = 0x97 // ZeroPage
+
+	// SMB2 - Set Memory Bit 2
+	SMB2_Z Instruction = 0xA7 // ZeroPage
+
+	// SMB3 - Set Memory Bit 3
+	SMB3_Z Instruction = 0xB7 // ZeroPage
+
+	// SMB4 - Set Memory Bit 4
+	SMB4_Z Instruction = 0xC7 // ZeroPage
+
+	// SMB5 - Set Memory Bit 5
+	SMB5_Z Instruction = 0xD7 // ZeroPage
+
+	// SMB6 - Set Memory Bit 6
+	SMB6_Z Instruction = 0xE7 // ZeroPage
+
+	// SMB7 - Set Memory Bit 7
+	SMB7_Z Instruction = 0xF7 // ZeroPage
+
+	// STA - Store Accumulator
+	STA_ZIND Instruction = 0x92 // ZeroPageIndirect
+
+	// STP - Stop the Clock
+	STP Instruction = 0xDB // Implied
+
+	// STZ - Store Zero
+	STZ_Z    Instruction = 0x64 // ZeroPage
+	STZ_ZX   Instruction = 0x74 // ZeroPageX
+	STZ_ABS  Instruction = 0x9C // Absolute
+	STZ_ABSX Instruction = 0x9E // AbsoluteX
+
+	// TRB - Test and Reset Bits
+	TRB_Z   Instruction = 0x14 // ZeroPage
+	TRB_ABS Instruction = 0x1C // Absolute
+
+	// TSB - Test and Set Bits
+	TSB_Z   Instruction = 0x04 // ZeroPage
+	TSB_ABS Instruction = 0x0C // Absolute
+
+	// WAI - Wait for Interrupt
+	WAI Instruction = 0xCB // Implied
+)
+
+// Instructions of w65816Opcodes
+//
+//goland:noinspection ALL
+const (
+	// ADC - Add with Carry
+	ADC_SR     Instruction = 0x63 // StackRelative
+	ADC_SRINDY Instruction = 0x73 // StackRelativeIndirectY
+	ADC_ZINDL  Instruction = 0x67 // ZeroPageIndirectLong
+	ADC_ZINDLY Instruction = 0x77 // ZeroPageIndirectLongY
+	ADC_ABSL   Instruction = 0x6F // AbsoluteLong
+	ADC_ABSLX  Instruction = 0x7F // AbsoluteLongX
+
+	// AND - Logical AND
+	AND_SR     Instruction = 0x23 // StackRelative
+	AND_SRINDY Instruction = 0x33 // StackRelativeIndirectY
+	AND_ZINDL  Instruction = 0x27 // ZeroPageIndirectLong
+	AND_ZINDLY Instruction = 0x37 // ZeroPageIndirectLongY
+	AND_ABSL   Instruction = 0x2F // AbsoluteLong
+	AND_ABSLX  Instruction = 0x3F // AbsoluteLongX
+
+	// BRL - Branch Always Long
+	BRL Instruction = 0x82 // RelativeLong
+
+	// CMP - Compare
+	CMP_SR     Instruction = 0xC3 // StackRelative
+	CMP_SRINDY Instruction = 0xD3 // StackRelativeIndirectY
+	CMP_ZINDL  Instruction = 0xC7 // ZeroPageIndirectLong
+	CMP_ZINDLY Instruction = 0xD7 // ZeroPageIndirectLongY
+	CMP_ABSL   Instruction = 0xCF // AbsoluteLong
+	CMP_ABSLX  Instruction = 0xDF // AbsoluteLongX
+
+	// COP - Co-Processor Enable
+	COP Instruction = 0x02 // Immediate
+
+	// EOR - Exclusive OR
+	EOR_SR     Instruction = 0x43 // StackRelative
+	EOR_SRINDY Instruction = 0x53 // StackRelativeIndirectY
+	EOR_ZINDL  Instruction = 0x47 // ZeroPageIndirectLong
+	EOR_ZINDLY Instruction = 0x57 // ZeroPageIndirectLongY
+	EOR_ABSL   Instruction = 0x4F // AbsoluteLong
+	EOR_ABSLX  Instruction = 0x5F // AbsoluteLongX
+
+	// JML - Jump Long
+	JML_ABSL Instruction = 0x5C // AbsoluteLong
+	JML_INDL Instruction = 0xDC // AbsoluteIndirectLong
+
+	// JSL - Jump to Subroutine Long
+	JSL_ABSL Instruction = 0x22 // AbsoluteLong
+
+	// JSR - Jump to Subroutine
+	JSR_INDABSX Instruction = 0xFC // AbsoluteIndexedIndirect
+
+	// LDA - Load Accumulator
+	LDA_SR     Instruction = 0xA3 // StackRelative
+	LDA_SRINDY Instruction = 0xB3 // StackRelativeIndirectY
+	LDA_ZINDL  Instruction = 0xA7 // ZeroPageIndirectLong
+	LDA_ZINDLY Instruction = 0xB7 // ZeroPageIndirectLongY
+	LDA_ABSL   Instruction = 0xAF // AbsoluteLong
+	LDA_ABSLX  Instruction = 0xBF // AbsoluteLongX
+
+	// MVN - Block Move Next
+	MVN Instruction = 0x54 // BlockMove
+
+	// MVP - Block Move Previous
+	MVP Instruction = 0x44 // BlockMove
+
+	// ORA - Logical Inclusive OR
+	ORA_SR     Instruction = 0x03 // StackRelative
+	ORA_SRINDY Instruction = 0x13 // StackRelativeIndirectY
+	ORA_ZINDL  Instruction = 0x07 // ZeroPageIndirectLong
+	ORA_ZINDLY Instruction = 0x17 // ZeroPageIndirectLongY
+	ORA_ABSL   Instruction = 0x0F // AbsoluteLong
+	ORA_ABSLX  Instruction = 0x1F // AbsoluteLongX
+
+	// PEA - Push Effective Absolute Address
+	PEA Instruction = 0xF4 // Absolute
+
+	// PEI - Push Effective Indirect Address
+	PEI Instruction = 0xD4 // ZeroPage
+
+	// PER - Push Effective PC Relative Address
+	PER Instruction = 0x62 // RelativeLong
+
+	// PHB - Push Data Bank Register
+	PHB Instruction = 0x8B // Implied
+
+	// PHD - Push Direct Page Register
+	PHD Instruction = 0x0B // Implied
+
+	// PHK - Push Program Bank Register
+	PHK Instruction = 0x4B // Implied
+
+	// PLB - Pull Data Bank Register
+	PLB Instruction = 0xAB // Implied
+
+	// PLD - Pull Direct Page Register
+	PLD Instruction = 0x2B // Implied
+
+	// REP - Reset Processor Status Bits
+	REP Instruction = 0xC2 // Immediate
+
+	// RTL - Return from Subroutine Long
+	RTL Instruction = 0x6B // Implied
+
+	// SBC - Subtract with Carry
+	SBC_SR     Instruction = 0xE3 // StackRelative
+	SBC_SRINDY Instruction = 0xF3 // StackRelativeIndirectY
+	SBC_ZINDL  Instruction = 0xE7 // ZeroPageIndirectLong
+	SBC_ZINDLY Instruction = 0xF7 // ZeroPageIndirectLongY
+	SBC_ABSL   Instruction = 0xEF // AbsoluteLong
+	SBC_ABSLX  Instruction = 0xFF // AbsoluteLongX
+
+	// SEP - Set Processor Status Bits
+	SEP Instruction = 0xE2 // Immediate
+
+	// STA - Store Accumulator
+	STA_SR     Instruction = 0x83 // StackRelative
+	STA_SRINDY Instruction = 0x93 // StackRelativeIndirectY
+	STA_ZINDL  Instruction = 0x87 // ZeroPageIndirectLong
+	STA_ZINDLY Instruction = 0x97 // ZeroPageIndirectLongY
+	STA_ABSL   Instruction = 0x8F // AbsoluteLong
+	STA_ABSLX  Instruction = 0x9F // AbsoluteLongX
+
+	// TCD - Transfer 16 bit Accumulator to Direct Page Register
+	TCD Instruction = 0x5B // Implied
+
+	// TCS - Transfer 16 bit Accumulator to Stack Pointer
+	TCS Instruction = 0x1B // Implied
+
+	// TDC - Transfer Direct Page Register to 16 bit Accumulator
+	TDC Instruction = 0x7B // Implied
+
+	// TSC - Transfer Stack Pointer to 16 bit Accumulator
+	TSC Instruction = 0x3B // Implied
+
+	// TXY - Transfer X to Y
+	TXY Instruction = 0x9B // Implied
+
+	// TYX - Transfer Y to X
+	TYX Instruction = 0xBB // Implied
+
+	// WDM - Reserved for Future Expansion
+	WDM Instruction = 0x42 // Immediate
+
+	// XBA - Exchange B and A Accumulator
+	XBA Instruction = 0xEB // Implied
+
+	// XCE - Exchange Carry and Emulation Flags
+	XCE Instruction = 0xFB // Implied
 )
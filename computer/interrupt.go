@@ -0,0 +1,101 @@
+package computer
+
+// Interrupt vectors of the 6502
+const (
+	// NMIVector holds the address of the non-maskable interrupt handler
+	NMIVector Address = 0xFFFA
+	// ResetVector holds the address the CPU starts at after a reset
+	ResetVector Address = 0xFFFC
+	// IRQVector holds the address of the interrupt handler, it is shared by IRQ and BRK
+	IRQVector Address = 0xFFFE
+)
+
+// AssertIRQ pulls the IRQ line low.
+//
+// IRQ is level triggered: the interrupt is taken at every instruction boundary while the line is
+// asserted and the interrupt disable flag is clear, so the device has to release it once it was served.
+func (cpu *SixFiveOTwo) AssertIRQ() {
+	cpu.irq = true
+}
+
+// ReleaseIRQ releases the IRQ line.
+func (cpu *SixFiveOTwo) ReleaseIRQ() {
+	cpu.irq = false
+}
+
+// TriggerNMI signals a falling edge on the NMI line.
+//
+// NMI is edge triggered and can not be disabled: the interrupt is taken once at the next instruction
+// boundary regardless of the interrupt disable flag.
+func (cpu *SixFiveOTwo) TriggerNMI() {
+	cpu.nmiPending = true
+}
+
+// serviceInterrupts is called at every instruction boundary and runs the interrupt sequence
+// if an NMI is pending or the IRQ line is asserted while interrupts are enabled.
+// Models without interrupt lines never take an interrupt.
+// It reports whether an interrupt was taken.
+func (cpu *SixFiveOTwo) serviceInterrupts(mem Memory) bool {
+	if !cpu.Model.hasInterruptLines() {
+		return false
+	}
+	var vector Address
+	switch {
+	case cpu.nmiPending:
+		cpu.nmiPending = false
+		vector = NMIVector
+		cpu.logger.LogE("NMI\n")
+	case cpu.irq && cpu.Status.GetInterruptDisableFlag() == 0:
+		vector = IRQVector
+		cpu.logger.LogE("IRQ\n")
+	default:
+		return false
+	}
+	// The 6502 spends two cycles reading the next instruction without executing it
+	cpu.programRead(mem, cpu.ProgramCounter)
+	cpu.programRead(mem, cpu.ProgramCounter)
+	cpu.interrupt(mem, vector, false)
+	return true
+}
+
+// interrupt pushes the ProgramCounter and the ProcessorStatus and continues at the address stored in the vector.
+//
+// An NMI that is pending by the time the vector is read hijacks the sequence: the CPU continues at the
+// NMI vector instead, even for a BRK. The B flag pushed on the stack is the only way to tell them apart.
+// The 65C02 clears the decimal flag, so handlers do not have to.
+func (cpu *SixFiveOTwo) interrupt(mem Memory, vector Address, breakFlag bool) {
+	cpu.pushAddress(mem, cpu.ProgramCounter)
+	cpu.pushStatus(mem, breakFlag)
+	cpu.Status.SetInterruptDisableFlag(true)
+	if cpu.Model.cmos() {
+		cpu.Status.SetDecimalFlag(false)
+	}
+	if vector != NMIVector && cpu.nmiPending {
+		cpu.logger.LogE("NMI hijacked the interrupt\n")
+		cpu.nmiPending = false
+		vector = NMIVector
+	}
+	cpu.ProgramCounter = cpu.readPointer(mem, vector, vector+1)
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
+
+// brk BRK - Force Interrupt
+//
+// The BRK instruction forces the generation of an interrupt request. The program counter and processor status
+// are pushed on the stack then the IRQ interrupt vector at $FFFE/F is loaded into the PC and the break flag in
+// the status set to one. The byte following the BRK is skipped.
+func (cpu *SixFiveOTwo) brk(mem Memory, _ operand) {
+	cpu.ProgramCounter++
+	cpu.interrupt(mem, IRQVector, true)
+}
+
+// rti RTI - Return from Interrupt
+//
+// The RTI instruction is used at the end of an interrupt processing routine.
+// It pulls the processor flags from the stack followed by the program counter.
+func (cpu *SixFiveOTwo) rti(mem Memory, _ operand) {
+	cpu.stackRead(mem)
+	cpu.pullStatus(mem)
+	cpu.ProgramCounter = cpu.pullAddress(mem)
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
@@ -0,0 +1,57 @@
+package computer
+
+// Registers of the on-chip I/O port of the MOS6510
+const (
+	// ioPortDirection is the data direction register, a set bit makes the pin an output
+	ioPortDirection Address = 0x0000
+	// ioPortData is the data register
+	ioPortData Address = 0x0001
+)
+
+// IOPort is the on-chip 8 bit I/O port of the MOS6510. The C64 uses it to switch the ROMs and
+// the I/O area in and out of the address space.
+//
+// The CPU reads the registers from the port instead of Memory. Writes go to the port and, like on the
+// real machine, to the RAM underneath as well.
+type IOPort struct {
+	// Direction is the data direction register at $00, a set bit makes the pin an output
+	Direction Word
+	// Data is the data register at $01
+	Data Word
+	// Input are the levels external hardware drives on the pins that are configured as inputs
+	Input Word
+	// OnChange is called after a write to the port, e.g. to switch the memory banks.
+	// It receives the levels of the pins, see Output.
+	OnChange func(output Word)
+}
+
+// Output returns the levels of the pins: the data register for outputs and Input for inputs.
+func (p *IOPort) Output() Word {
+	return p.Data&p.Direction | p.Input&^p.Direction
+}
+
+// reset makes all pins inputs like the reset line of the MOS6510 does, the data register keeps its value.
+func (p *IOPort) reset() {
+	p.Direction = 0
+	if p.OnChange != nil {
+		p.OnChange(p.Output())
+	}
+}
+
+func (p *IOPort) read(address Address) Word {
+	if address == ioPortDirection {
+		return p.Direction
+	}
+	return p.Output()
+}
+
+func (p *IOPort) write(address Address, value Word) {
+	if address == ioPortDirection {
+		p.Direction = value
+	} else {
+		p.Data = value
+	}
+	if p.OnChange != nil {
+		p.OnChange(p.Output())
+	}
+}
@@ -0,0 +1,53 @@
+package computer
+
+// lda LDA - Load Accumulator
+//
+// Loads a byte of memory into the accumulator setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) lda(mem Memory, op operand) {
+	cpu.loadIntoRegisterFromOperand(&cpu.Accumulator, mem, op)
+	cpu.logger.LogE("%s\n", cpu.Accumulator)
+}
+
+// ldx LDX - Load X Register
+//
+// Loads a byte of memory into the X register setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) ldx(mem Memory, op operand) {
+	cpu.loadIntoRegisterFromOperand(&cpu.RegisterX, mem, op)
+	cpu.logger.LogE("%s\n", cpu.RegisterX)
+}
+
+// ldy LDY - Load Y Register
+//
+// Loads a byte of memory into the Y register setting the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) ldy(mem Memory, op operand) {
+	cpu.loadIntoRegisterFromOperand(&cpu.RegisterY, mem, op)
+	cpu.logger.LogE("%s\n", cpu.RegisterY)
+}
+
+// sta STA - Store Accumulator
+//
+// Stores the contents of the accumulator into memory.
+func (cpu *SixFiveOTwo) sta(mem Memory, op operand) {
+	cpu.writeOperand(mem, op, cpu.Accumulator)
+}
+
+// stx STX - Store X Register
+//
+// Stores the contents of the X register into memory.
+func (cpu *SixFiveOTwo) stx(mem Memory, op operand) {
+	cpu.writeOperand(mem, op, cpu.RegisterX)
+}
+
+// sty STY - Store Y Register
+//
+// Stores the contents of the Y register into memory.
+func (cpu *SixFiveOTwo) sty(mem Memory, op operand) {
+	cpu.writeOperand(mem, op, cpu.RegisterY)
+}
+
+// stz STZ - Store Zero
+//
+// Stores zero into memory (65C02 only).
+func (cpu *SixFiveOTwo) stz(mem Memory, op operand) {
+	cpu.writeOperand(mem, op, 0)
+}
@@ -0,0 +1,63 @@
+package computer
+
+// and AND - Logical AND
+//
+// A logical AND is performed, bit by bit, on the accumulator contents using the contents of a byte of memory.
+func (cpu *SixFiveOTwo) and(mem Memory, op operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator&cpu.readOperand(mem, op))
+	cpu.logger.LogE("%s\n", cpu.Accumulator)
+}
+
+// ora ORA - Logical Inclusive OR
+//
+// An inclusive OR is performed, bit by bit, on the accumulator contents using the contents of a byte of memory.
+func (cpu *SixFiveOTwo) ora(mem Memory, op operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator|cpu.readOperand(mem, op))
+	cpu.logger.LogE("%s\n", cpu.Accumulator)
+}
+
+// eor EOR - Exclusive OR
+//
+// An exclusive OR is performed, bit by bit, on the accumulator contents using the contents of a byte of memory.
+func (cpu *SixFiveOTwo) eor(mem Memory, op operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator^cpu.readOperand(mem, op))
+	cpu.logger.LogE("%s\n", cpu.Accumulator)
+}
+
+// bit BIT - Bit Test
+//
+// This instructions is used to test if one or more bits are set in a target memory location.
+// The mask pattern in A is ANDed with the value in memory to set or clear the zero flag, but the result is not kept.
+// Bits 7 and 6 of the value from memory are copied into the N and V flags.
+// The immediate form of the 65C02 only affects the zero flag.
+func (cpu *SixFiveOTwo) bit(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op)
+	cpu.Status.SetZeroFlag(cpu.Accumulator&value == 0)
+	if op.mode == Immediate {
+		return
+	}
+	cpu.Status.SetNegativeFlag(value&bit7 != 0)
+	cpu.Status.SetOverflowFlag(value&bit6 != 0)
+}
+
+// trb TRB - Test and Reset Bits
+//
+// The bits set in the accumulator are cleared in memory (65C02 only).
+// The zero flag is set like BIT would set it for the original value.
+func (cpu *SixFiveOTwo) trb(mem Memory, op operand) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		cpu.Status.SetZeroFlag(cpu.Accumulator&value == 0)
+		return value &^ cpu.Accumulator
+	})
+}
+
+// tsb TSB - Test and Set Bits
+//
+// The bits set in the accumulator are set in memory (65C02 only).
+// The zero flag is set like BIT would set it for the original value.
+func (cpu *SixFiveOTwo) tsb(mem Memory, op operand) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		cpu.Status.SetZeroFlag(cpu.Accumulator&value == 0)
+		return value | cpu.Accumulator
+	})
+}
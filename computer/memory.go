@@ -30,6 +30,28 @@ type Memory interface {
 		---------------+----------------+------------------------------------
 	*/
 	ReadAddress(source Address) Address
+
+	// Peek returns the value at the address like a debugger sees it. It never has side effects on
+	// devices (see Peeker) and the CPU does not count a cycle for it.
+	Peek(address Address) Word
+
+	// Poke stores the value at the address like a debugger changes it, without side effects on devices.
+	Poke(address Address, value Word)
+}
+
+// FaultReporter is implemented by a Memory or Device that can refuse an access, like a ROM with HaltOnROMWrite.
+// Step checks the Memory after every instruction and returns the fault.
+type FaultReporter interface {
+	// Fault returns the fault of the accesses since the last call and clears it, nil if there was none
+	Fault() error
+}
+
+// memoryFault returns the fault of the Memory (or Memory24) if it is a FaultReporter.
+func memoryFault(mem any) error {
+	if reporter, ok := mem.(FaultReporter); ok {
+		return reporter.Fault()
+	}
+	return nil
 }
 
 type Memory16K struct {
@@ -50,6 +72,14 @@ func (mem *Memory16K) ReadAddress(source Address) Address {
 	return Address(uint16(msb)<<8 | uint16(lsb))
 }
 
+func (mem *Memory16K) Peek(address Address) Word {
+	return mem.Data[address]
+}
+
+func (mem *Memory16K) Poke(address Address, value Word) {
+	mem.Data[address] = value
+}
+
 // Init initializes the memory with default values
 func (mem *Memory16K) Init() error {
 	mem.Data = make([]Word, math.MaxUint16+1)
@@ -72,10 +102,10 @@ func (mem Memory16K) String() string {
 		fmt.Fprintf(&sb, "── Blocks from %#04X to %#04X ──\n", i, i+blockSize-1)
 		for j := 0; j < blockSize/collums; j++ {
 			i1 := i + j
-			v1 := mem.Data[i1]
+			v1 := uint8(mem.Peek(Address(i1)))
 			fmt.Fprintf(&sb, "[%#04X] %#02X ─ ", i1, v1)
 			i2 := j + i + blockSize/collums
-			v2 := mem.Data[i2]
+			v2 := uint8(mem.Peek(Address(i2)))
 			fmt.Fprintf(&sb, "[%#04X] %#02X\n", i2, v2)
 		}
 	}
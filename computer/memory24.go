@@ -0,0 +1,149 @@
+package computer
+
+import "fmt"
+
+// LongAddress is a 24 bit address of the 65816. The bank is in bits 16 to 23, the 16 bit Address inside the bank in bits 0 to 15.
+type LongAddress uint32
+
+// longAddressMask keeps the 24 bits the 65816 puts on the bus
+const longAddressMask LongAddress = 0xFFFFFF
+
+// NewLongAddress combines a bank and an address inside the bank.
+func NewLongAddress(bank Word, address Address) LongAddress {
+	return LongAddress(bank)<<16 | LongAddress(address)
+}
+
+// Bank returns the bank of the address.
+func (a LongAddress) Bank() Word {
+	return Word(a >> 16)
+}
+
+// Address returns the 16 bit address inside the bank.
+func (a LongAddress) Address() Address {
+	return Address(a)
+}
+
+func (a LongAddress) String() string {
+	return fmt.Sprintf("%#06x", uint32(a))
+}
+
+// Memory24 is the memory of the 65816, it is addressed with 24 bit addresses.
+// Use Memory16M for a flat 16 MB memory or Memory24From to run the 65816 on a 6502 Memory.
+type Memory24 interface {
+	// Init initializes the memory. Should be called before use.
+	Init() error
+
+	// WriteLong stores a single word value at the specified destination address.
+	WriteLong(destination LongAddress, value Word)
+
+	// ReadLong retrieves a word from the specified source address.
+	ReadLong(source LongAddress) Word
+
+	// PeekLong returns the value at the address without side effects, see Memory.Peek.
+	PeekLong(address LongAddress) Word
+
+	// PokeLong stores the value at the address without side effects, see Memory.Poke.
+	PokeLong(address LongAddress, value Word)
+}
+
+// Memory16M is a flat memory covering all 256 banks of the 65816.
+type Memory16M struct {
+	Data []Word
+}
+
+// Init initializes the memory with default values
+func (mem *Memory16M) Init() error {
+	mem.Data = make([]Word, longAddressMask+1)
+	return nil
+}
+
+func (mem *Memory16M) WriteLong(destination LongAddress, value Word) {
+	mem.Data[destination&longAddressMask] = value
+}
+
+func (mem *Memory16M) ReadLong(source LongAddress) Word {
+	return mem.Data[source&longAddressMask]
+}
+
+func (mem *Memory16M) PeekLong(address LongAddress) Word {
+	return mem.Data[address&longAddressMask]
+}
+
+func (mem *Memory16M) PokeLong(address LongAddress, value Word) {
+	mem.Data[address&longAddressMask] = value
+}
+
+// Memory24From wraps a 16 bit Memory for the 65816. The bank is ignored, so every bank mirrors the
+// same 64K. This is enough for code that stays in bank 0, like a 65816 in emulation mode that leaves the bank
+// registers cleared.
+func Memory24From(mem Memory) Memory24 {
+	return mirroredBanks{mem}
+}
+
+// mirroredBanks maps every bank to the same Memory, see Memory24From
+type mirroredBanks struct {
+	mem Memory
+}
+
+func (m mirroredBanks) Init() error {
+	return m.mem.Init()
+}
+
+func (m mirroredBanks) WriteLong(destination LongAddress, value Word) {
+	m.mem.WriteWord(destination.Address(), value)
+}
+
+func (m mirroredBanks) ReadLong(source LongAddress) Word {
+	return m.mem.ReadWord(source.Address())
+}
+
+func (m mirroredBanks) PeekLong(address LongAddress) Word {
+	return m.mem.Peek(address.Address())
+}
+
+func (m mirroredBanks) PokeLong(address LongAddress, value Word) {
+	m.mem.Poke(address.Address(), value)
+}
+
+// Fault returns the fault of the wrapped Memory, see FaultReporter.
+func (m mirroredBanks) Fault() error {
+	return memoryFault(m.mem)
+}
+
+// memoryBank is a single bank of a Memory24 as a Memory. It connects the Bus to the bank of an access and
+// gives the core of the 65816 the banks it accesses in emulation mode, see emulationBanks.
+type memoryBank struct {
+	mem  Memory24
+	bank Word
+}
+
+func (m *memoryBank) Init() error {
+	return m.mem.Init()
+}
+
+func (m *memoryBank) WriteWord(destination Address, value Word) {
+	m.mem.WriteLong(NewLongAddress(m.bank, destination), value)
+}
+
+func (m *memoryBank) ReadWord(source Address) Word {
+	return m.mem.ReadLong(NewLongAddress(m.bank, source))
+}
+
+func (m *memoryBank) WriteAddress(destination Address, address Address) {
+	m.WriteWord(destination, Word(address))
+	m.WriteWord(destination+1, Word(address>>8))
+}
+
+func (m *memoryBank) ReadAddress(source Address) Address {
+	lsb := m.ReadWord(source)
+	msb := m.ReadWord(source + 1)
+	return Address(msb)<<8 | Address(lsb)
+}
+
+func (m *memoryBank) Peek(address Address) Word {
+	return m.mem.PeekLong(NewLongAddress(m.bank, address))
+}
+
+func (m *memoryBank) Poke(address Address, value Word) {
+	m.mem.PokeLong(NewLongAddress(m.bank, address), value)
+}
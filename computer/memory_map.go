@@ -0,0 +1,218 @@
+package computer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Device is a chip on the bus of a MemoryMap, like RAM, ROM or an I/O chip.
+// It is addressed with the offset of the address inside the region it is mapped to.
+type Device interface {
+	// Read returns the value at the offset, reading a register of an I/O chip may have side effects.
+	Read(offset Address) Word
+	// Write stores the value at the offset.
+	Write(offset Address, value Word)
+}
+
+// Peeker is implemented by Devices that can be inspected and changed without side effects, see Memory.Peek.
+// Reading the data register of a UART consumes the received byte, peeking at it does not.
+type Peeker interface {
+	Peek(offset Address) Word
+	Poke(offset Address, value Word)
+}
+
+// Sizer is implemented by Devices of a fixed size, Map rejects regions that are larger than the Device.
+type Sizer interface {
+	// Size returns the number of offsets the Device decodes
+	Size() int
+}
+
+// forwarder is implemented by Devices that pass every access on to another Device, like a Mirror or a
+// BankSwitch. Peek and Poke are passed on as well, so the Device behind decides whether it is a Peeker.
+type forwarder interface {
+	// target returns the Device and the offset an access to the offset is passed on to
+	target(offset Address) (Device, Address)
+}
+
+// MemoryMap is a Memory that routes every access to the Device mapped to the address, like the address
+// decoder of a real board. Regions that are mapped later take precedence over earlier ones, so an I/O chip
+// can be mapped over a part of the RAM.
+//
+// Reading an address no Device is mapped to returns the open bus: the last value that was on the data bus.
+type MemoryMap struct {
+	regions []region
+	// dataBus is the last value read from or written to a Device
+	dataBus Word
+}
+
+// region is the address range a Device is mapped to, start and end are inclusive.
+type region struct {
+	start, end Address
+	device     Device
+}
+
+// NewMemoryMap returns an empty MemoryMap, every address reads the open bus until Devices are mapped.
+func NewMemoryMap() *MemoryMap {
+	return &MemoryMap{}
+}
+
+// Map maps the Device to the addresses from start to end inclusive.
+// The Device is addressed with the offset to start, it has to cover the whole region. This is checked
+// for Devices that are a Sizer.
+func (m *MemoryMap) Map(start, end Address, device Device) error {
+	if end < start {
+		return &MappingError{Start: start, End: end, Reason: "the region ends before it starts"}
+	}
+	if device == nil {
+		return &MappingError{Start: start, End: end, Reason: "no device"}
+	}
+	if sizer, ok := device.(Sizer); ok && sizer.Size() < int(end-start)+1 {
+		return &MappingError{Start: start, End: end, Reason: fmt.Sprintf("the device has only %#04x bytes", sizer.Size())}
+	}
+	m.regions = append(m.regions, region{start: start, end: end, device: device})
+	return nil
+}
+
+// lookup returns the region the address is mapped to, or false for the open bus.
+func (m *MemoryMap) lookup(address Address) (region, bool) {
+	for i := len(m.regions) - 1; i >= 0; i-- {
+		if r := m.regions[i]; address >= r.start && address <= r.end {
+			return r, true
+		}
+	}
+	return region{}, false
+}
+
+// Init initializes the mapped Devices that have an Init method, e.g. clears the RAM.
+func (m *MemoryMap) Init() error {
+	for _, r := range m.regions {
+		if device, ok := r.device.(interface{ Init() error }); ok {
+			if err := device.Init(); err != nil {
+				return err
+			}
+		}
+	}
+	m.dataBus = 0
+	return nil
+}
+
+// Fault returns the first fault of the mapped Devices that are a FaultReporter, see FaultReporter.
+func (m *MemoryMap) Fault() error {
+	var fault error
+	for _, r := range m.regions {
+		if reporter, ok := r.device.(FaultReporter); ok {
+			if err := reporter.Fault(); err != nil && fault == nil {
+				fault = err
+			}
+		}
+	}
+	return fault
+}
+
+func (m *MemoryMap) ReadWord(source Address) Word {
+	r, ok := m.lookup(source)
+	if !ok {
+		return m.dataBus
+	}
+	m.dataBus = r.device.Read(source - r.start)
+	return m.dataBus
+}
+
+func (m *MemoryMap) WriteWord(destination Address, value Word) {
+	m.dataBus = value
+	if r, ok := m.lookup(destination); ok {
+		r.device.Write(destination-r.start, value)
+	}
+}
+
+// Peek returns the value at the address without side effects. Devices that are no Peeker can not be
+// read without risking one, so they show the open bus like unmapped addresses.
+func (m *MemoryMap) Peek(address Address) Word {
+	if r, ok := m.lookup(address); ok {
+		if peeker, offset, ok := peekerOf(r.device, address-r.start); ok {
+			return peeker.Peek(offset)
+		}
+	}
+	return m.dataBus
+}
+
+// Poke stores the value at the address without side effects, Devices that are no Peeker are not changed.
+func (m *MemoryMap) Poke(address Address, value Word) {
+	if r, ok := m.lookup(address); ok {
+		if peeker, offset, ok := peekerOf(r.device, address-r.start); ok {
+			peeker.Poke(offset, value)
+		}
+	}
+}
+
+// peekerOf follows forwarders to the Device that is accessed at the offset and reports whether it is a Peeker.
+func peekerOf(device Device, offset Address) (Peeker, Address, bool) {
+	for {
+		f, ok := device.(forwarder)
+		if !ok {
+			break
+		}
+		device, offset = f.target(offset)
+	}
+	peeker, ok := device.(Peeker)
+	return peeker, offset, ok
+}
+
+func (m *MemoryMap) ReadAddress(source Address) Address {
+	lsb := m.ReadWord(source)
+	msb := m.ReadWord(source + 1)
+	return Address(msb)<<8 | Address(lsb)
+}
+
+func (m *MemoryMap) WriteAddress(destination Address, address Address) {
+	m.WriteWord(destination, Word(address))
+	m.WriteWord(destination+1, Word(address>>8))
+}
+
+// String lists the mapped regions, Devices that are a fmt.Stringer describe their state, e.g. the selected bank.
+func (m *MemoryMap) String() string {
+	sb := strings.Builder{}
+	for _, r := range m.regions {
+		_, _ = fmt.Fprintf(&sb, "%s - %s %T", r.start, r.end, r.device)
+		if s, ok := r.device.(fmt.Stringer); ok {
+			_, _ = fmt.Fprintf(&sb, " %s", s)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// RAM is a Device of read/write memory.
+type RAM []Word
+
+// NewRAM returns a RAM of the given size in bytes.
+func NewRAM(size int) RAM {
+	return make(RAM, size)
+}
+
+// Size returns the size of the RAM in bytes, see Sizer.
+func (r RAM) Size() int {
+	return len(r)
+}
+
+// Init clears the RAM
+func (r RAM) Init() error {
+	clear(r)
+	return nil
+}
+
+func (r RAM) Read(offset Address) Word {
+	return r[offset]
+}
+
+func (r RAM) Write(offset Address, value Word) {
+	r[offset] = value
+}
+
+func (r RAM) Peek(offset Address) Word {
+	return r[offset]
+}
+
+func (r RAM) Poke(offset Address, value Word) {
+	r[offset] = value
+}
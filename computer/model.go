@@ -0,0 +1,85 @@
+package computer
+
+// Model selects the variant of the 6502 the core emulates.
+//
+// The zero value is the NMOS MOS6502, all variants share the same core and only differ
+// in their opcode table, their timing and a few quirks.
+type Model uint8
+
+const (
+	// MOS6502 the original NMOS 6502
+	MOS6502 Model = iota
+	// WDC65C02 the CMOS W65C02S including the Rockwell bit instructions, WAI and STP.
+	// It fixes the JMP indirect page bug, clears the decimal flag on interrupts and sets
+	// valid N and Z flags in decimal mode. Its reserved opcodes are NOPs.
+	WDC65C02
+	// Ricoh2A03 the CPU of the NES. The decimal flag can be set but ADC and SBC ignore it.
+	Ricoh2A03
+	// MOS6507 the CPU of the Atari 2600. Only 13 address lines are connected, so every address is
+	// masked to $0000-$1FFF before it reaches Memory. It has no IRQ and NMI lines.
+	MOS6507
+	// MOS6510 the CPU of the C64. It has an I/O port at $00 (data direction) and $01 (data),
+	// see SixFiveOTwo.Port.
+	MOS6510
+
+	// w65816Emulation the WDC 65816 in emulation mode, see SixFiveEightSixteen. It is not exported because
+	// the core alone can not run the instructions the 65816 added to the 65C02.
+	w65816Emulation
+)
+
+var modelNames = [...]string{
+	MOS6502:   "MOS6502",
+	WDC65C02:  "WDC65C02",
+	Ricoh2A03: "Ricoh2A03",
+	MOS6507:   "MOS6507",
+	MOS6510:   "MOS6510",
+
+	w65816Emulation: "WDC65816",
+}
+
+func (m Model) String() string {
+	if int(m) < len(modelNames) {
+		return modelNames[m]
+	}
+	return "Model(" + Word(m).String() + ")"
+}
+
+// LookupOpcode returns the opcode table entry the model executes for the given instruction.
+// The undocumented NMOS opcodes are not part of it, see LookupUndocumentedOpcode.
+func (m Model) LookupOpcode(instruction Instruction) Opcode {
+	if m.cmos() {
+		return cmosOpcodes[instruction]
+	}
+	return nmosOpcodes[instruction]
+}
+
+// cmos reports whether the model is one of the CMOS variants.
+func (m Model) cmos() bool {
+	return m == WDC65C02 || m == w65816Emulation
+}
+
+// c02Timing reports whether the model has the bus cycles of the W65C02S: it reads the last operand byte again
+// instead of an invalid address, reads the operand of a read-modify-write twice instead of writing it back,
+// spends a cycle on fixing the JMP indirect bug and one on the flags of a decimal ADC or SBC.
+// The 65816 fixes the bug without a cycle and keeps the NMOS bus cycles otherwise.
+func (m Model) c02Timing() bool {
+	return m == WDC65C02
+}
+
+// addressMask is applied to every address before it is put on the address bus.
+func (m Model) addressMask() Address {
+	if m == MOS6507 {
+		return 0x1FFF
+	}
+	return 0xFFFF
+}
+
+// hasDecimalMode reports whether ADC and SBC honour the decimal flag.
+func (m Model) hasDecimalMode() bool {
+	return m != Ricoh2A03
+}
+
+// hasInterruptLines reports whether the IRQ and NMI lines are connected.
+func (m Model) hasInterruptLines() bool {
+	return m != MOS6507
+}
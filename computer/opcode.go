@@ -0,0 +1,30 @@
+//go:generate go run gen_opcodes.go
+
+package computer
+
+// Opcode describes a single entry of the opcode table.
+//
+// The table itself is generated from gen_opcodes.go together with the Instruction constants,
+// so all information about an opcode lives in one place.
+type Opcode struct {
+	// Mnemonic is the assembler mnemonic, e.g. "LDA". It is empty for opcodes the emulator does not know.
+	Mnemonic string
+	// Mode is the addressing mode used to locate the operand.
+	Mode AddressingMode
+	// Bytes is the length of the instruction including the opcode byte.
+	Bytes uint8
+	// Cycles is the base cycle count as published in the data sheets.
+	Cycles uint8
+
+	execute func(cpu *SixFiveOTwo, mem Memory, op operand)
+}
+
+// Defined reports whether the opcode is part of the table.
+func (o Opcode) Defined() bool {
+	return o.Mnemonic != ""
+}
+
+// LookupOpcode returns the opcode table entry for the given instruction.
+func LookupOpcode(instruction Instruction) Opcode {
+	return nmosOpcodes[instruction]
+}
@@ -0,0 +1,1047 @@
+// Code generated by "go run gen_opcodes.go"; DO NOT EDIT.
+
+package computer
+
+// nmosOpcodes holds the official instruction set of the NMOS 6502.
+var nmosOpcodes = [256]Opcode{
+	BRK:      {Mnemonic: "BRK", Mode: Implied, Bytes: 1, Cycles: 7, execute: (*SixFiveOTwo).brk},
+	ORA_INDX: {Mnemonic: "ORA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).ora},
+	ORA_Z:    {Mnemonic: "ORA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ora},
+	ASL_Z:    {Mnemonic: "ASL", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).asl},
+	PHP:      {Mnemonic: "PHP", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).php},
+	ORA_I:    {Mnemonic: "ORA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ora},
+	ASL_A:    {Mnemonic: "ASL", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).asl},
+	ORA_ABS:  {Mnemonic: "ORA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ABS:  {Mnemonic: "ASL", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	BPL:      {Mnemonic: "BPL", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bpl},
+	ORA_INDY: {Mnemonic: "ORA", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ora},
+	ORA_ZX:   {Mnemonic: "ORA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ZX:   {Mnemonic: "ASL", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	CLC:      {Mnemonic: "CLC", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).clc},
+	ORA_ABSY: {Mnemonic: "ORA", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ORA_ABSX: {Mnemonic: "ORA", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ABSX: {Mnemonic: "ASL", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).asl},
+	JSR_ABS:  {Mnemonic: "JSR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).jsr},
+	AND_INDX: {Mnemonic: "AND", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).and},
+	BIT_Z:    {Mnemonic: "BIT", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).bit},
+	AND_Z:    {Mnemonic: "AND", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).and},
+	ROL_Z:    {Mnemonic: "ROL", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).rol},
+	PLP:      {Mnemonic: "PLP", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).plp},
+	AND_I:    {Mnemonic: "AND", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).and},
+	ROL_A:    {Mnemonic: "ROL", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).rol},
+	BIT_ABS:  {Mnemonic: "BIT", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ABS:  {Mnemonic: "AND", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ABS:  {Mnemonic: "ROL", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	BMI:      {Mnemonic: "BMI", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bmi},
+	AND_INDY: {Mnemonic: "AND", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).and},
+	AND_ZX:   {Mnemonic: "AND", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ZX:   {Mnemonic: "ROL", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	SEC:      {Mnemonic: "SEC", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sec},
+	AND_ABSY: {Mnemonic: "AND", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	AND_ABSX: {Mnemonic: "AND", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ABSX: {Mnemonic: "ROL", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).rol},
+	RTI:      {Mnemonic: "RTI", Mode: Implied, Bytes: 1, Cycles: 6, execute: (*SixFiveOTwo).rti},
+	EOR_INDX: {Mnemonic: "EOR", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).eor},
+	EOR_Z:    {Mnemonic: "EOR", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).eor},
+	LSR_Z:    {Mnemonic: "LSR", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lsr},
+	PHA:      {Mnemonic: "PHA", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).pha},
+	EOR_I:    {Mnemonic: "EOR", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).eor},
+	LSR_A:    {Mnemonic: "LSR", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).lsr},
+	JMP_ABS:  {Mnemonic: "JMP", Mode: Absolute, Bytes: 3, Cycles: 3, execute: (*SixFiveOTwo).jmp},
+	EOR_ABS:  {Mnemonic: "EOR", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ABS:  {Mnemonic: "LSR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	BVC:      {Mnemonic: "BVC", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bvc},
+	EOR_INDY: {Mnemonic: "EOR", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).eor},
+	EOR_ZX:   {Mnemonic: "EOR", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ZX:   {Mnemonic: "LSR", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	CLI:      {Mnemonic: "CLI", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).cli},
+	EOR_ABSY: {Mnemonic: "EOR", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	EOR_ABSX: {Mnemonic: "EOR", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ABSX: {Mnemonic: "LSR", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).lsr},
+	RTS:      {Mnemonic: "RTS", Mode: Implied, Bytes: 1, Cycles: 6, execute: (*SixFiveOTwo).rts},
+	ADC_INDX: {Mnemonic: "ADC", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).adc},
+	ADC_Z:    {Mnemonic: "ADC", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).adc},
+	ROR_Z:    {Mnemonic: "ROR", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ror},
+	PLA:      {Mnemonic: "PLA", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).pla},
+	ADC_I:    {Mnemonic: "ADC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).adc},
+	ROR_A:    {Mnemonic: "ROR", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).ror},
+	JMP_IND:  {Mnemonic: "JMP", Mode: Indirect, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).jmp},
+	ADC_ABS:  {Mnemonic: "ADC", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ABS:  {Mnemonic: "ROR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	BVS:      {Mnemonic: "BVS", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bvs},
+	ADC_INDY: {Mnemonic: "ADC", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).adc},
+	ADC_ZX:   {Mnemonic: "ADC", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ZX:   {Mnemonic: "ROR", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	SEI:      {Mnemonic: "SEI", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sei},
+	ADC_ABSY: {Mnemonic: "ADC", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ADC_ABSX: {Mnemonic: "ADC", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ABSX: {Mnemonic: "ROR", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).ror},
+	STA_INDX: {Mnemonic: "STA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sta},
+	STY_Z:    {Mnemonic: "STY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sty},
+	STA_Z:    {Mnemonic: "STA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sta},
+	STX_Z:    {Mnemonic: "STX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).stx},
+	DEY:      {Mnemonic: "DEY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dey},
+	TXA:      {Mnemonic: "TXA", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).txa},
+	STY_ABS:  {Mnemonic: "STY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sty},
+	STA_ABS:  {Mnemonic: "STA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sta},
+	STX_ABS:  {Mnemonic: "STX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).stx},
+	BCC:      {Mnemonic: "BCC", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bcc},
+	STA_INDY: {Mnemonic: "STA", Mode: IndirectIndexed, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sta},
+	STY_ZX:   {Mnemonic: "STY", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sty},
+	STA_ZX:   {Mnemonic: "STA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sta},
+	STX_ZY:   {Mnemonic: "STX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).stx},
+	TYA:      {Mnemonic: "TYA", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tya},
+	STA_ABSY: {Mnemonic: "STA", Mode: AbsoluteY, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	TXS:      {Mnemonic: "TXS", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).txs},
+	STA_ABSX: {Mnemonic: "STA", Mode: AbsoluteX, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	LDY_I:    {Mnemonic: "LDY", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ldy},
+	LDA_INDX: {Mnemonic: "LDA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lda},
+	LDX_I:    {Mnemonic: "LDX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ldx},
+	LDY_Z:    {Mnemonic: "LDY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ldy},
+	LDA_Z:    {Mnemonic: "LDA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).lda},
+	LDX_Z:    {Mnemonic: "LDX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ldx},
+	TAY:      {Mnemonic: "TAY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tay},
+	LDA_I:    {Mnemonic: "LDA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).lda},
+	TAX:      {Mnemonic: "TAX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tax},
+	LDY_ABS:  {Mnemonic: "LDY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ABS:  {Mnemonic: "LDA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ABS:  {Mnemonic: "LDX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	BCS:      {Mnemonic: "BCS", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bcs},
+	LDA_INDY: {Mnemonic: "LDA", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lda},
+	LDY_ZX:   {Mnemonic: "LDY", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ZX:   {Mnemonic: "LDA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ZY:   {Mnemonic: "LDX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	CLV:      {Mnemonic: "CLV", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).clv},
+	LDA_ABSY: {Mnemonic: "LDA", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	TSX:      {Mnemonic: "TSX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tsx},
+	LDY_ABSX: {Mnemonic: "LDY", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ABSX: {Mnemonic: "LDA", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ABSY: {Mnemonic: "LDX", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	CPY_I:    {Mnemonic: "CPY", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cpy},
+	CMP_INDX: {Mnemonic: "CMP", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).cmp},
+	CPY_Z:    {Mnemonic: "CPY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cpy},
+	CMP_Z:    {Mnemonic: "CMP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cmp},
+	DEC_Z:    {Mnemonic: "DEC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).dec},
+	INY:      {Mnemonic: "INY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).iny},
+	CMP_I:    {Mnemonic: "CMP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cmp},
+	DEX:      {Mnemonic: "DEX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dex},
+	CPY_ABS:  {Mnemonic: "CPY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cpy},
+	CMP_ABS:  {Mnemonic: "CMP", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ABS:  {Mnemonic: "DEC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).dec},
+	BNE:      {Mnemonic: "BNE", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bne},
+	CMP_INDY: {Mnemonic: "CMP", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).cmp},
+	CMP_ZX:   {Mnemonic: "CMP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ZX:   {Mnemonic: "DEC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).dec},
+	CLD:      {Mnemonic: "CLD", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).cld},
+	CMP_ABSY: {Mnemonic: "CMP", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	CMP_ABSX: {Mnemonic: "CMP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ABSX: {Mnemonic: "DEC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).dec},
+	CPX_I:    {Mnemonic: "CPX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cpx},
+	SBC_INDX: {Mnemonic: "SBC", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sbc},
+	CPX_Z:    {Mnemonic: "CPX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cpx},
+	SBC_Z:    {Mnemonic: "SBC", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sbc},
+	INC_Z:    {Mnemonic: "INC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).inc},
+	INX:      {Mnemonic: "INX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).inx},
+	SBC_I:    {Mnemonic: "SBC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).sbc},
+	NOP:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	CPX_ABS:  {Mnemonic: "CPX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cpx},
+	SBC_ABS:  {Mnemonic: "SBC", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ABS:  {Mnemonic: "INC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).inc},
+	BEQ:      {Mnemonic: "BEQ", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).beq},
+	SBC_INDY: {Mnemonic: "SBC", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sbc},
+	SBC_ZX:   {Mnemonic: "SBC", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ZX:   {Mnemonic: "INC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).inc},
+	SED:      {Mnemonic: "SED", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sed},
+	SBC_ABSY: {Mnemonic: "SBC", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	SBC_ABSX: {Mnemonic: "SBC", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ABSX: {Mnemonic: "INC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).inc},
+}
+
+// undocumentedOpcodes holds the undocumented opcodes of the NMOS 6502, see SixFiveOTwo.UndocumentedOpcodes.
+var undocumentedOpcodes = [256]Opcode{
+	JAM_02:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	SLO_INDX: {Mnemonic: "SLO", Mode: IndexedIndirect, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).slo},
+	NOP_04:   {Mnemonic: "NOP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).nop},
+	SLO_Z:    {Mnemonic: "SLO", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).slo},
+	ANC_I:    {Mnemonic: "ANC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).anc},
+	NOP_0C:   {Mnemonic: "NOP", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SLO_ABS:  {Mnemonic: "SLO", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).slo},
+	JAM_12:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	SLO_INDY: {Mnemonic: "SLO", Mode: IndirectIndexed, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).slo},
+	NOP_14:   {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SLO_ZX:   {Mnemonic: "SLO", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).slo},
+	NOP_1A:   {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	SLO_ABSY: {Mnemonic: "SLO", Mode: AbsoluteY, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).slo},
+	NOP_1C:   {Mnemonic: "NOP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SLO_ABSX: {Mnemonic: "SLO", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).slo},
+	JAM_22:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	RLA_INDX: {Mnemonic: "RLA", Mode: IndexedIndirect, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).rla},
+	RLA_Z:    {Mnemonic: "RLA", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).rla},
+	ANC_2B:   {Mnemonic: "ANC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).anc},
+	RLA_ABS:  {Mnemonic: "RLA", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).rla},
+	JAM_32:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	RLA_INDY: {Mnemonic: "RLA", Mode: IndirectIndexed, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).rla},
+	NOP_34:   {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	RLA_ZX:   {Mnemonic: "RLA", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).rla},
+	NOP_3A:   {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	RLA_ABSY: {Mnemonic: "RLA", Mode: AbsoluteY, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).rla},
+	NOP_3C:   {Mnemonic: "NOP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	RLA_ABSX: {Mnemonic: "RLA", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).rla},
+	JAM_42:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	SRE_INDX: {Mnemonic: "SRE", Mode: IndexedIndirect, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).sre},
+	NOP_44:   {Mnemonic: "NOP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).nop},
+	SRE_Z:    {Mnemonic: "SRE", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sre},
+	ALR_I:    {Mnemonic: "ALR", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).alr},
+	SRE_ABS:  {Mnemonic: "SRE", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).sre},
+	JAM_52:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	SRE_INDY: {Mnemonic: "SRE", Mode: IndirectIndexed, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).sre},
+	NOP_54:   {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SRE_ZX:   {Mnemonic: "SRE", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sre},
+	NOP_5A:   {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	SRE_ABSY: {Mnemonic: "SRE", Mode: AbsoluteY, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).sre},
+	NOP_5C:   {Mnemonic: "NOP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SRE_ABSX: {Mnemonic: "SRE", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).sre},
+	JAM_62:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	RRA_INDX: {Mnemonic: "RRA", Mode: IndexedIndirect, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).rra},
+	NOP_64:   {Mnemonic: "NOP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).nop},
+	RRA_Z:    {Mnemonic: "RRA", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).rra},
+	ARR_I:    {Mnemonic: "ARR", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).arr},
+	RRA_ABS:  {Mnemonic: "RRA", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).rra},
+	JAM_72:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	RRA_INDY: {Mnemonic: "RRA", Mode: IndirectIndexed, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).rra},
+	NOP_74:   {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	RRA_ZX:   {Mnemonic: "RRA", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).rra},
+	NOP_7A:   {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	RRA_ABSY: {Mnemonic: "RRA", Mode: AbsoluteY, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).rra},
+	NOP_7C:   {Mnemonic: "NOP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	RRA_ABSX: {Mnemonic: "RRA", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).rra},
+	NOP_80:   {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_82:   {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	SAX_INDX: {Mnemonic: "SAX", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sax},
+	SAX_Z:    {Mnemonic: "SAX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sax},
+	NOP_89:   {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	ANE_I:    {Mnemonic: "ANE", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ane},
+	SAX_ABS:  {Mnemonic: "SAX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sax},
+	JAM_92:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	SHA_INDY: {Mnemonic: "SHA", Mode: IndirectIndexed, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sha},
+	SAX_ZY:   {Mnemonic: "SAX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sax},
+	TAS_ABSY: {Mnemonic: "TAS", Mode: AbsoluteY, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).tas},
+	SHY_ABSX: {Mnemonic: "SHY", Mode: AbsoluteX, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).shy},
+	SHX_ABSY: {Mnemonic: "SHX", Mode: AbsoluteY, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).shx},
+	SHA_ABSY: {Mnemonic: "SHA", Mode: AbsoluteY, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sha},
+	LAX_INDX: {Mnemonic: "LAX", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lax},
+	LAX_Z:    {Mnemonic: "LAX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).lax},
+	LXA_I:    {Mnemonic: "LXA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).lxa},
+	LAX_ABS:  {Mnemonic: "LAX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lax},
+	JAM_B2:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	LAX_INDY: {Mnemonic: "LAX", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lax},
+	LAX_ZY:   {Mnemonic: "LAX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).lax},
+	LAS_ABSY: {Mnemonic: "LAS", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).las},
+	LAX_ABSY: {Mnemonic: "LAX", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lax},
+	NOP_C2:   {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	DCP_INDX: {Mnemonic: "DCP", Mode: IndexedIndirect, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).dcp},
+	DCP_Z:    {Mnemonic: "DCP", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).dcp},
+	SBX_I:    {Mnemonic: "SBX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).sbx},
+	DCP_ABS:  {Mnemonic: "DCP", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).dcp},
+	JAM_D2:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	DCP_INDY: {Mnemonic: "DCP", Mode: IndirectIndexed, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).dcp},
+	NOP_D4:   {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	DCP_ZX:   {Mnemonic: "DCP", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).dcp},
+	NOP_DA:   {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	DCP_ABSY: {Mnemonic: "DCP", Mode: AbsoluteY, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).dcp},
+	NOP_DC:   {Mnemonic: "NOP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	DCP_ABSX: {Mnemonic: "DCP", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).dcp},
+	NOP_E2:   {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	ISC_INDX: {Mnemonic: "ISC", Mode: IndexedIndirect, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).isc},
+	ISC_Z:    {Mnemonic: "ISC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).isc},
+	SBC_EB:   {Mnemonic: "SBC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).sbc},
+	ISC_ABS:  {Mnemonic: "ISC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).isc},
+	JAM_F2:   {Mnemonic: "JAM", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).jam},
+	ISC_INDY: {Mnemonic: "ISC", Mode: IndirectIndexed, Bytes: 2, Cycles: 8, execute: (*SixFiveOTwo).isc},
+	NOP_F4:   {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	ISC_ZX:   {Mnemonic: "ISC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).isc},
+	NOP_FA:   {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	ISC_ABSY: {Mnemonic: "ISC", Mode: AbsoluteY, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).isc},
+	NOP_FC:   {Mnemonic: "NOP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	ISC_ABSX: {Mnemonic: "ISC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).isc},
+}
+
+// cmosOpcodes holds the instruction set of the WDC 65C02.
+var cmosOpcodes = [256]Opcode{
+	BRK:         {Mnemonic: "BRK", Mode: Implied, Bytes: 1, Cycles: 7, execute: (*SixFiveOTwo).brk},
+	ORA_INDX:    {Mnemonic: "ORA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).ora},
+	NOP_02:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_03:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	TSB_Z:       {Mnemonic: "TSB", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).tsb},
+	ORA_Z:       {Mnemonic: "ORA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ora},
+	ASL_Z:       {Mnemonic: "ASL", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).asl},
+	RMB0_Z:      {Mnemonic: "RMB0", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 0) }},
+	PHP:         {Mnemonic: "PHP", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).php},
+	ORA_I:       {Mnemonic: "ORA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ora},
+	ASL_A:       {Mnemonic: "ASL", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).asl},
+	NOP_0B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	TSB_ABS:     {Mnemonic: "TSB", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).tsb},
+	ORA_ABS:     {Mnemonic: "ORA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ABS:     {Mnemonic: "ASL", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	BBR0:        {Mnemonic: "BBR0", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 0) }},
+	BPL:         {Mnemonic: "BPL", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bpl},
+	ORA_INDY:    {Mnemonic: "ORA", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ora},
+	ORA_ZIND:    {Mnemonic: "ORA", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ora},
+	NOP_13:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	TRB_Z:       {Mnemonic: "TRB", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).trb},
+	ORA_ZX:      {Mnemonic: "ORA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ZX:      {Mnemonic: "ASL", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	RMB1_Z:      {Mnemonic: "RMB1", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 1) }},
+	CLC:         {Mnemonic: "CLC", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).clc},
+	ORA_ABSY:    {Mnemonic: "ORA", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	INC_A:       {Mnemonic: "INC", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).inc},
+	NOP_1B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	TRB_ABS:     {Mnemonic: "TRB", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).trb},
+	ORA_ABSX:    {Mnemonic: "ORA", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ABSX:    {Mnemonic: "ASL", Mode: AbsoluteX, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	BBR1:        {Mnemonic: "BBR1", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 1) }},
+	JSR_ABS:     {Mnemonic: "JSR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).jsr},
+	AND_INDX:    {Mnemonic: "AND", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).and},
+	NOP_22:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_23:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	BIT_Z:       {Mnemonic: "BIT", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).bit},
+	AND_Z:       {Mnemonic: "AND", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).and},
+	ROL_Z:       {Mnemonic: "ROL", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).rol},
+	RMB2_Z:      {Mnemonic: "RMB2", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 2) }},
+	PLP:         {Mnemonic: "PLP", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).plp},
+	AND_I:       {Mnemonic: "AND", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).and},
+	ROL_A:       {Mnemonic: "ROL", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).rol},
+	NOP_2B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	BIT_ABS:     {Mnemonic: "BIT", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ABS:     {Mnemonic: "AND", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ABS:     {Mnemonic: "ROL", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	BBR2:        {Mnemonic: "BBR2", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 2) }},
+	BMI:         {Mnemonic: "BMI", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bmi},
+	AND_INDY:    {Mnemonic: "AND", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).and},
+	AND_ZIND:    {Mnemonic: "AND", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).and},
+	NOP_33:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	BIT_ZX:      {Mnemonic: "BIT", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ZX:      {Mnemonic: "AND", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ZX:      {Mnemonic: "ROL", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	RMB3_Z:      {Mnemonic: "RMB3", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 3) }},
+	SEC:         {Mnemonic: "SEC", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sec},
+	AND_ABSY:    {Mnemonic: "AND", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	DEC_A:       {Mnemonic: "DEC", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dec},
+	NOP_3B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	BIT_ABSX:    {Mnemonic: "BIT", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ABSX:    {Mnemonic: "AND", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ABSX:    {Mnemonic: "ROL", Mode: AbsoluteX, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	BBR3:        {Mnemonic: "BBR3", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 3) }},
+	RTI:         {Mnemonic: "RTI", Mode: Implied, Bytes: 1, Cycles: 6, execute: (*SixFiveOTwo).rti},
+	EOR_INDX:    {Mnemonic: "EOR", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).eor},
+	NOP_42:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_43:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	NOP_44:      {Mnemonic: "NOP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).nop},
+	EOR_Z:       {Mnemonic: "EOR", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).eor},
+	LSR_Z:       {Mnemonic: "LSR", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lsr},
+	RMB4_Z:      {Mnemonic: "RMB4", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 4) }},
+	PHA:         {Mnemonic: "PHA", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).pha},
+	EOR_I:       {Mnemonic: "EOR", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).eor},
+	LSR_A:       {Mnemonic: "LSR", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).lsr},
+	NOP_4B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	JMP_ABS:     {Mnemonic: "JMP", Mode: Absolute, Bytes: 3, Cycles: 3, execute: (*SixFiveOTwo).jmp},
+	EOR_ABS:     {Mnemonic: "EOR", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ABS:     {Mnemonic: "LSR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	BBR4:        {Mnemonic: "BBR4", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 4) }},
+	BVC:         {Mnemonic: "BVC", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bvc},
+	EOR_INDY:    {Mnemonic: "EOR", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).eor},
+	EOR_ZIND:    {Mnemonic: "EOR", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).eor},
+	NOP_53:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	NOP_54:      {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	EOR_ZX:      {Mnemonic: "EOR", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ZX:      {Mnemonic: "LSR", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	RMB5_Z:      {Mnemonic: "RMB5", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 5) }},
+	CLI:         {Mnemonic: "CLI", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).cli},
+	EOR_ABSY:    {Mnemonic: "EOR", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	PHY:         {Mnemonic: "PHY", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).phy},
+	NOP_5B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	NOP_5C:      {Mnemonic: "NOP", Mode: Absolute, Bytes: 3, Cycles: 8, execute: (*SixFiveOTwo).nopLong},
+	EOR_ABSX:    {Mnemonic: "EOR", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ABSX:    {Mnemonic: "LSR", Mode: AbsoluteX, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	BBR5:        {Mnemonic: "BBR5", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 5) }},
+	RTS:         {Mnemonic: "RTS", Mode: Implied, Bytes: 1, Cycles: 6, execute: (*SixFiveOTwo).rts},
+	ADC_INDX:    {Mnemonic: "ADC", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).adc},
+	NOP_62:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_63:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	STZ_Z:       {Mnemonic: "STZ", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).stz},
+	ADC_Z:       {Mnemonic: "ADC", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).adc},
+	ROR_Z:       {Mnemonic: "ROR", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ror},
+	RMB6_Z:      {Mnemonic: "RMB6", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 6) }},
+	PLA:         {Mnemonic: "PLA", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).pla},
+	ADC_I:       {Mnemonic: "ADC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).adc},
+	ROR_A:       {Mnemonic: "ROR", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).ror},
+	NOP_6B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	JMP_IND:     {Mnemonic: "JMP", Mode: Indirect, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).jmp},
+	ADC_ABS:     {Mnemonic: "ADC", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ABS:     {Mnemonic: "ROR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	BBR6:        {Mnemonic: "BBR6", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 6) }},
+	BVS:         {Mnemonic: "BVS", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bvs},
+	ADC_INDY:    {Mnemonic: "ADC", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).adc},
+	ADC_ZIND:    {Mnemonic: "ADC", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).adc},
+	NOP_73:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	STZ_ZX:      {Mnemonic: "STZ", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).stz},
+	ADC_ZX:      {Mnemonic: "ADC", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ZX:      {Mnemonic: "ROR", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	RMB7_Z:      {Mnemonic: "RMB7", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.rmb(mem, op, 7) }},
+	SEI:         {Mnemonic: "SEI", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sei},
+	ADC_ABSY:    {Mnemonic: "ADC", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	PLY:         {Mnemonic: "PLY", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).ply},
+	NOP_7B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	JMP_INDABSX: {Mnemonic: "JMP", Mode: AbsoluteIndexedIndirect, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).jmp},
+	ADC_ABSX:    {Mnemonic: "ADC", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ABSX:    {Mnemonic: "ROR", Mode: AbsoluteX, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	BBR7:        {Mnemonic: "BBR7", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbr(mem, op, 7) }},
+	BRA:         {Mnemonic: "BRA", Mode: Relative, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).bra},
+	STA_INDX:    {Mnemonic: "STA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sta},
+	NOP_82:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_83:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	STY_Z:       {Mnemonic: "STY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sty},
+	STA_Z:       {Mnemonic: "STA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sta},
+	STX_Z:       {Mnemonic: "STX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).stx},
+	SMB0_Z:      {Mnemonic: "SMB0", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 0) }},
+	DEY:         {Mnemonic: "DEY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dey},
+	BIT_I:       {Mnemonic: "BIT", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bit},
+	TXA:         {Mnemonic: "TXA", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).txa},
+	NOP_8B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	STY_ABS:     {Mnemonic: "STY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sty},
+	STA_ABS:     {Mnemonic: "STA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sta},
+	STX_ABS:     {Mnemonic: "STX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).stx},
+	BBS0:        {Mnemonic: "BBS0", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 0) }},
+	BCC:         {Mnemonic: "BCC", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bcc},
+	STA_INDY:    {Mnemonic: "STA", Mode: IndirectIndexed, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sta},
+	STA_ZIND:    {Mnemonic: "STA", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	NOP_93:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	STY_ZX:      {Mnemonic: "STY", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sty},
+	STA_ZX:      {Mnemonic: "STA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sta},
+	STX_ZY:      {Mnemonic: "STX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).stx},
+	SMB1_Z:      {Mnemonic: "SMB1", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 1) }},
+	TYA:         {Mnemonic: "TYA", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tya},
+	STA_ABSY:    {Mnemonic: "STA", Mode: AbsoluteY, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	TXS:         {Mnemonic: "TXS", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).txs},
+	NOP_9B:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	STZ_ABS:     {Mnemonic: "STZ", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).stz},
+	STA_ABSX:    {Mnemonic: "STA", Mode: AbsoluteX, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	STZ_ABSX:    {Mnemonic: "STZ", Mode: AbsoluteX, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).stz},
+	BBS1:        {Mnemonic: "BBS1", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 1) }},
+	LDY_I:       {Mnemonic: "LDY", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ldy},
+	LDA_INDX:    {Mnemonic: "LDA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lda},
+	LDX_I:       {Mnemonic: "LDX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ldx},
+	NOP_A3:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	LDY_Z:       {Mnemonic: "LDY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ldy},
+	LDA_Z:       {Mnemonic: "LDA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).lda},
+	LDX_Z:       {Mnemonic: "LDX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ldx},
+	SMB2_Z:      {Mnemonic: "SMB2", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 2) }},
+	TAY:         {Mnemonic: "TAY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tay},
+	LDA_I:       {Mnemonic: "LDA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).lda},
+	TAX:         {Mnemonic: "TAX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tax},
+	NOP_AB:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	LDY_ABS:     {Mnemonic: "LDY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ABS:     {Mnemonic: "LDA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ABS:     {Mnemonic: "LDX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	BBS2:        {Mnemonic: "BBS2", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 2) }},
+	BCS:         {Mnemonic: "BCS", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bcs},
+	LDA_INDY:    {Mnemonic: "LDA", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lda},
+	LDA_ZIND:    {Mnemonic: "LDA", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lda},
+	NOP_B3:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	LDY_ZX:      {Mnemonic: "LDY", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ZX:      {Mnemonic: "LDA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ZY:      {Mnemonic: "LDX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	SMB3_Z:      {Mnemonic: "SMB3", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 3) }},
+	CLV:         {Mnemonic: "CLV", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).clv},
+	LDA_ABSY:    {Mnemonic: "LDA", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	TSX:         {Mnemonic: "TSX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tsx},
+	NOP_BB:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	LDY_ABSX:    {Mnemonic: "LDY", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ABSX:    {Mnemonic: "LDA", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ABSY:    {Mnemonic: "LDX", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	BBS3:        {Mnemonic: "BBS3", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 3) }},
+	CPY_I:       {Mnemonic: "CPY", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cpy},
+	CMP_INDX:    {Mnemonic: "CMP", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).cmp},
+	NOP_C2:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_C3:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	CPY_Z:       {Mnemonic: "CPY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cpy},
+	CMP_Z:       {Mnemonic: "CMP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cmp},
+	DEC_Z:       {Mnemonic: "DEC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).dec},
+	SMB4_Z:      {Mnemonic: "SMB4", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 4) }},
+	INY:         {Mnemonic: "INY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).iny},
+	CMP_I:       {Mnemonic: "CMP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cmp},
+	DEX:         {Mnemonic: "DEX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dex},
+	WAI:         {Mnemonic: "WAI", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).wai},
+	CPY_ABS:     {Mnemonic: "CPY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cpy},
+	CMP_ABS:     {Mnemonic: "CMP", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ABS:     {Mnemonic: "DEC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).dec},
+	BBS4:        {Mnemonic: "BBS4", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 4) }},
+	BNE:         {Mnemonic: "BNE", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bne},
+	CMP_INDY:    {Mnemonic: "CMP", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).cmp},
+	CMP_ZIND:    {Mnemonic: "CMP", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).cmp},
+	NOP_D3:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	NOP_D4:      {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	CMP_ZX:      {Mnemonic: "CMP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ZX:      {Mnemonic: "DEC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).dec},
+	SMB5_Z:      {Mnemonic: "SMB5", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 5) }},
+	CLD:         {Mnemonic: "CLD", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).cld},
+	CMP_ABSY:    {Mnemonic: "CMP", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	PHX:         {Mnemonic: "PHX", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).phx},
+	STP:         {Mnemonic: "STP", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).stp},
+	NOP_DC:      {Mnemonic: "NOP", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	CMP_ABSX:    {Mnemonic: "CMP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ABSX:    {Mnemonic: "DEC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).dec},
+	BBS5:        {Mnemonic: "BBS5", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 5) }},
+	CPX_I:       {Mnemonic: "CPX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cpx},
+	SBC_INDX:    {Mnemonic: "SBC", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sbc},
+	NOP_E2:      {Mnemonic: "NOP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_E3:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	CPX_Z:       {Mnemonic: "CPX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cpx},
+	SBC_Z:       {Mnemonic: "SBC", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sbc},
+	INC_Z:       {Mnemonic: "INC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).inc},
+	SMB6_Z:      {Mnemonic: "SMB6", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 6) }},
+	INX:         {Mnemonic: "INX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).inx},
+	SBC_I:       {Mnemonic: "SBC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).sbc},
+	NOP:         {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	NOP_EB:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	CPX_ABS:     {Mnemonic: "CPX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cpx},
+	SBC_ABS:     {Mnemonic: "SBC", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ABS:     {Mnemonic: "INC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).inc},
+	BBS6:        {Mnemonic: "BBS6", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 6) }},
+	BEQ:         {Mnemonic: "BEQ", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).beq},
+	SBC_INDY:    {Mnemonic: "SBC", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sbc},
+	SBC_ZIND:    {Mnemonic: "SBC", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sbc},
+	NOP_F3:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	NOP_F4:      {Mnemonic: "NOP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SBC_ZX:      {Mnemonic: "SBC", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ZX:      {Mnemonic: "INC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).inc},
+	SMB7_Z:      {Mnemonic: "SMB7", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.smb(mem, op, 7) }},
+	SED:         {Mnemonic: "SED", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sed},
+	SBC_ABSY:    {Mnemonic: "SBC", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	PLX:         {Mnemonic: "PLX", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).plx},
+	NOP_FB:      {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 1, execute: (*SixFiveOTwo).nop},
+	NOP_FC:      {Mnemonic: "NOP", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).nop},
+	SBC_ABSX:    {Mnemonic: "SBC", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ABSX:    {Mnemonic: "INC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).inc},
+	BBS7:        {Mnemonic: "BBS7", Mode: ZeroPageRelative, Bytes: 3, Cycles: 5, execute: func(cpu *SixFiveOTwo, mem Memory, op operand) { cpu.bbs(mem, op, 7) }},
+}
+
+// w65816Opcodes holds the instruction set of the WDC 65816.
+var w65816Opcodes = [256]Opcode{
+	BRK:         {Mnemonic: "BRK", Mode: Implied, Bytes: 1, Cycles: 7, execute: (*SixFiveOTwo).brk},
+	ORA_INDX:    {Mnemonic: "ORA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).ora},
+	COP:         {Mnemonic: "COP", Mode: Immediate, Bytes: 2, Cycles: 7},
+	ORA_SR:      {Mnemonic: "ORA", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	TSB_Z:       {Mnemonic: "TSB", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).tsb},
+	ORA_Z:       {Mnemonic: "ORA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ora},
+	ASL_Z:       {Mnemonic: "ASL", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).asl},
+	ORA_ZINDL:   {Mnemonic: "ORA", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	PHP:         {Mnemonic: "PHP", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).php},
+	ORA_I:       {Mnemonic: "ORA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ora},
+	ASL_A:       {Mnemonic: "ASL", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).asl},
+	PHD:         {Mnemonic: "PHD", Mode: Implied, Bytes: 1, Cycles: 4},
+	TSB_ABS:     {Mnemonic: "TSB", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).tsb},
+	ORA_ABS:     {Mnemonic: "ORA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ABS:     {Mnemonic: "ASL", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	ORA_ABSL:    {Mnemonic: "ORA", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BPL:         {Mnemonic: "BPL", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bpl},
+	ORA_INDY:    {Mnemonic: "ORA", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ora},
+	ORA_ZIND:    {Mnemonic: "ORA", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ora},
+	ORA_SRINDY:  {Mnemonic: "ORA", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	TRB_Z:       {Mnemonic: "TRB", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).trb},
+	ORA_ZX:      {Mnemonic: "ORA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ZX:      {Mnemonic: "ASL", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).asl},
+	ORA_ZINDLY:  {Mnemonic: "ORA", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	CLC:         {Mnemonic: "CLC", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).clc},
+	ORA_ABSY:    {Mnemonic: "ORA", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	INC_A:       {Mnemonic: "INC", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).inc},
+	TCS:         {Mnemonic: "TCS", Mode: Implied, Bytes: 1, Cycles: 2},
+	TRB_ABS:     {Mnemonic: "TRB", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).trb},
+	ORA_ABSX:    {Mnemonic: "ORA", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ora},
+	ASL_ABSX:    {Mnemonic: "ASL", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).asl},
+	ORA_ABSLX:   {Mnemonic: "ORA", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	JSR_ABS:     {Mnemonic: "JSR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).jsr},
+	AND_INDX:    {Mnemonic: "AND", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).and},
+	JSL_ABSL:    {Mnemonic: "JSL", Mode: AbsoluteLong, Bytes: 4, Cycles: 8},
+	AND_SR:      {Mnemonic: "AND", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	BIT_Z:       {Mnemonic: "BIT", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).bit},
+	AND_Z:       {Mnemonic: "AND", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).and},
+	ROL_Z:       {Mnemonic: "ROL", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).rol},
+	AND_ZINDL:   {Mnemonic: "AND", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	PLP:         {Mnemonic: "PLP", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).plp},
+	AND_I:       {Mnemonic: "AND", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).and},
+	ROL_A:       {Mnemonic: "ROL", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).rol},
+	PLD:         {Mnemonic: "PLD", Mode: Implied, Bytes: 1, Cycles: 5},
+	BIT_ABS:     {Mnemonic: "BIT", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ABS:     {Mnemonic: "AND", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ABS:     {Mnemonic: "ROL", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	AND_ABSL:    {Mnemonic: "AND", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BMI:         {Mnemonic: "BMI", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bmi},
+	AND_INDY:    {Mnemonic: "AND", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).and},
+	AND_ZIND:    {Mnemonic: "AND", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).and},
+	AND_SRINDY:  {Mnemonic: "AND", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	BIT_ZX:      {Mnemonic: "BIT", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ZX:      {Mnemonic: "AND", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ZX:      {Mnemonic: "ROL", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).rol},
+	AND_ZINDLY:  {Mnemonic: "AND", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	SEC:         {Mnemonic: "SEC", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sec},
+	AND_ABSY:    {Mnemonic: "AND", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	DEC_A:       {Mnemonic: "DEC", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dec},
+	TSC:         {Mnemonic: "TSC", Mode: Implied, Bytes: 1, Cycles: 2},
+	BIT_ABSX:    {Mnemonic: "BIT", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).bit},
+	AND_ABSX:    {Mnemonic: "AND", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).and},
+	ROL_ABSX:    {Mnemonic: "ROL", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).rol},
+	AND_ABSLX:   {Mnemonic: "AND", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	RTI:         {Mnemonic: "RTI", Mode: Implied, Bytes: 1, Cycles: 6, execute: (*SixFiveOTwo).rti},
+	EOR_INDX:    {Mnemonic: "EOR", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).eor},
+	WDM:         {Mnemonic: "WDM", Mode: Immediate, Bytes: 2, Cycles: 2},
+	EOR_SR:      {Mnemonic: "EOR", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	MVP:         {Mnemonic: "MVP", Mode: BlockMove, Bytes: 3, Cycles: 7},
+	EOR_Z:       {Mnemonic: "EOR", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).eor},
+	LSR_Z:       {Mnemonic: "LSR", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lsr},
+	EOR_ZINDL:   {Mnemonic: "EOR", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	PHA:         {Mnemonic: "PHA", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).pha},
+	EOR_I:       {Mnemonic: "EOR", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).eor},
+	LSR_A:       {Mnemonic: "LSR", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).lsr},
+	PHK:         {Mnemonic: "PHK", Mode: Implied, Bytes: 1, Cycles: 3},
+	JMP_ABS:     {Mnemonic: "JMP", Mode: Absolute, Bytes: 3, Cycles: 3, execute: (*SixFiveOTwo).jmp},
+	EOR_ABS:     {Mnemonic: "EOR", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ABS:     {Mnemonic: "LSR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	EOR_ABSL:    {Mnemonic: "EOR", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BVC:         {Mnemonic: "BVC", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bvc},
+	EOR_INDY:    {Mnemonic: "EOR", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).eor},
+	EOR_ZIND:    {Mnemonic: "EOR", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).eor},
+	EOR_SRINDY:  {Mnemonic: "EOR", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	MVN:         {Mnemonic: "MVN", Mode: BlockMove, Bytes: 3, Cycles: 7},
+	EOR_ZX:      {Mnemonic: "EOR", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ZX:      {Mnemonic: "LSR", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lsr},
+	EOR_ZINDLY:  {Mnemonic: "EOR", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	CLI:         {Mnemonic: "CLI", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).cli},
+	EOR_ABSY:    {Mnemonic: "EOR", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	PHY:         {Mnemonic: "PHY", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).phy},
+	TCD:         {Mnemonic: "TCD", Mode: Implied, Bytes: 1, Cycles: 2},
+	JML_ABSL:    {Mnemonic: "JML", Mode: AbsoluteLong, Bytes: 4, Cycles: 4},
+	EOR_ABSX:    {Mnemonic: "EOR", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).eor},
+	LSR_ABSX:    {Mnemonic: "LSR", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).lsr},
+	EOR_ABSLX:   {Mnemonic: "EOR", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	RTS:         {Mnemonic: "RTS", Mode: Implied, Bytes: 1, Cycles: 6, execute: (*SixFiveOTwo).rts},
+	ADC_INDX:    {Mnemonic: "ADC", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).adc},
+	PER:         {Mnemonic: "PER", Mode: RelativeLong, Bytes: 3, Cycles: 6},
+	ADC_SR:      {Mnemonic: "ADC", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	STZ_Z:       {Mnemonic: "STZ", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).stz},
+	ADC_Z:       {Mnemonic: "ADC", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).adc},
+	ROR_Z:       {Mnemonic: "ROR", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).ror},
+	ADC_ZINDL:   {Mnemonic: "ADC", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	PLA:         {Mnemonic: "PLA", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).pla},
+	ADC_I:       {Mnemonic: "ADC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).adc},
+	ROR_A:       {Mnemonic: "ROR", Mode: Accumulator, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).ror},
+	RTL:         {Mnemonic: "RTL", Mode: Implied, Bytes: 1, Cycles: 6},
+	JMP_IND:     {Mnemonic: "JMP", Mode: Indirect, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).jmp},
+	ADC_ABS:     {Mnemonic: "ADC", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ABS:     {Mnemonic: "ROR", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	ADC_ABSL:    {Mnemonic: "ADC", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BVS:         {Mnemonic: "BVS", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bvs},
+	ADC_INDY:    {Mnemonic: "ADC", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).adc},
+	ADC_ZIND:    {Mnemonic: "ADC", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).adc},
+	ADC_SRINDY:  {Mnemonic: "ADC", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	STZ_ZX:      {Mnemonic: "STZ", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).stz},
+	ADC_ZX:      {Mnemonic: "ADC", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ZX:      {Mnemonic: "ROR", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).ror},
+	ADC_ZINDLY:  {Mnemonic: "ADC", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	SEI:         {Mnemonic: "SEI", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sei},
+	ADC_ABSY:    {Mnemonic: "ADC", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	PLY:         {Mnemonic: "PLY", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).ply},
+	TDC:         {Mnemonic: "TDC", Mode: Implied, Bytes: 1, Cycles: 2},
+	JMP_INDABSX: {Mnemonic: "JMP", Mode: AbsoluteIndexedIndirect, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).jmp},
+	ADC_ABSX:    {Mnemonic: "ADC", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).adc},
+	ROR_ABSX:    {Mnemonic: "ROR", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).ror},
+	ADC_ABSLX:   {Mnemonic: "ADC", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	BRA:         {Mnemonic: "BRA", Mode: Relative, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).bra},
+	STA_INDX:    {Mnemonic: "STA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sta},
+	BRL:         {Mnemonic: "BRL", Mode: RelativeLong, Bytes: 3, Cycles: 4},
+	STA_SR:      {Mnemonic: "STA", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	STY_Z:       {Mnemonic: "STY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sty},
+	STA_Z:       {Mnemonic: "STA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sta},
+	STX_Z:       {Mnemonic: "STX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).stx},
+	STA_ZINDL:   {Mnemonic: "STA", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	DEY:         {Mnemonic: "DEY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dey},
+	BIT_I:       {Mnemonic: "BIT", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bit},
+	TXA:         {Mnemonic: "TXA", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).txa},
+	PHB:         {Mnemonic: "PHB", Mode: Implied, Bytes: 1, Cycles: 3},
+	STY_ABS:     {Mnemonic: "STY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sty},
+	STA_ABS:     {Mnemonic: "STA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sta},
+	STX_ABS:     {Mnemonic: "STX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).stx},
+	STA_ABSL:    {Mnemonic: "STA", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BCC:         {Mnemonic: "BCC", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bcc},
+	STA_INDY:    {Mnemonic: "STA", Mode: IndirectIndexed, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sta},
+	STA_ZIND:    {Mnemonic: "STA", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	STA_SRINDY:  {Mnemonic: "STA", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	STY_ZX:      {Mnemonic: "STY", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sty},
+	STA_ZX:      {Mnemonic: "STA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sta},
+	STX_ZY:      {Mnemonic: "STX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).stx},
+	STA_ZINDLY:  {Mnemonic: "STA", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	TYA:         {Mnemonic: "TYA", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tya},
+	STA_ABSY:    {Mnemonic: "STA", Mode: AbsoluteY, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	TXS:         {Mnemonic: "TXS", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).txs},
+	TXY:         {Mnemonic: "TXY", Mode: Implied, Bytes: 1, Cycles: 2},
+	STZ_ABS:     {Mnemonic: "STZ", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).stz},
+	STA_ABSX:    {Mnemonic: "STA", Mode: AbsoluteX, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).sta},
+	STZ_ABSX:    {Mnemonic: "STZ", Mode: AbsoluteX, Bytes: 3, Cycles: 5, execute: (*SixFiveOTwo).stz},
+	STA_ABSLX:   {Mnemonic: "STA", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	LDY_I:       {Mnemonic: "LDY", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ldy},
+	LDA_INDX:    {Mnemonic: "LDA", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).lda},
+	LDX_I:       {Mnemonic: "LDX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).ldx},
+	LDA_SR:      {Mnemonic: "LDA", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	LDY_Z:       {Mnemonic: "LDY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ldy},
+	LDA_Z:       {Mnemonic: "LDA", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).lda},
+	LDX_Z:       {Mnemonic: "LDX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).ldx},
+	LDA_ZINDL:   {Mnemonic: "LDA", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	TAY:         {Mnemonic: "TAY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tay},
+	LDA_I:       {Mnemonic: "LDA", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).lda},
+	TAX:         {Mnemonic: "TAX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tax},
+	PLB:         {Mnemonic: "PLB", Mode: Implied, Bytes: 1, Cycles: 4},
+	LDY_ABS:     {Mnemonic: "LDY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ABS:     {Mnemonic: "LDA", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ABS:     {Mnemonic: "LDX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	LDA_ABSL:    {Mnemonic: "LDA", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BCS:         {Mnemonic: "BCS", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bcs},
+	LDA_INDY:    {Mnemonic: "LDA", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lda},
+	LDA_ZIND:    {Mnemonic: "LDA", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).lda},
+	LDA_SRINDY:  {Mnemonic: "LDA", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	LDY_ZX:      {Mnemonic: "LDY", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ZX:      {Mnemonic: "LDA", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ZY:      {Mnemonic: "LDX", Mode: ZeroPageY, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	LDA_ZINDLY:  {Mnemonic: "LDA", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	CLV:         {Mnemonic: "CLV", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).clv},
+	LDA_ABSY:    {Mnemonic: "LDA", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	TSX:         {Mnemonic: "TSX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).tsx},
+	TYX:         {Mnemonic: "TYX", Mode: Implied, Bytes: 1, Cycles: 2},
+	LDY_ABSX:    {Mnemonic: "LDY", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldy},
+	LDA_ABSX:    {Mnemonic: "LDA", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).lda},
+	LDX_ABSY:    {Mnemonic: "LDX", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).ldx},
+	LDA_ABSLX:   {Mnemonic: "LDA", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	CPY_I:       {Mnemonic: "CPY", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cpy},
+	CMP_INDX:    {Mnemonic: "CMP", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).cmp},
+	REP:         {Mnemonic: "REP", Mode: Immediate, Bytes: 2, Cycles: 3},
+	CMP_SR:      {Mnemonic: "CMP", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	CPY_Z:       {Mnemonic: "CPY", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cpy},
+	CMP_Z:       {Mnemonic: "CMP", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cmp},
+	DEC_Z:       {Mnemonic: "DEC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).dec},
+	CMP_ZINDL:   {Mnemonic: "CMP", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	INY:         {Mnemonic: "INY", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).iny},
+	CMP_I:       {Mnemonic: "CMP", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cmp},
+	DEX:         {Mnemonic: "DEX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).dex},
+	WAI:         {Mnemonic: "WAI", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).wai},
+	CPY_ABS:     {Mnemonic: "CPY", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cpy},
+	CMP_ABS:     {Mnemonic: "CMP", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ABS:     {Mnemonic: "DEC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).dec},
+	CMP_ABSL:    {Mnemonic: "CMP", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BNE:         {Mnemonic: "BNE", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).bne},
+	CMP_INDY:    {Mnemonic: "CMP", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).cmp},
+	CMP_ZIND:    {Mnemonic: "CMP", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).cmp},
+	CMP_SRINDY:  {Mnemonic: "CMP", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	PEI:         {Mnemonic: "PEI", Mode: ZeroPage, Bytes: 2, Cycles: 6},
+	CMP_ZX:      {Mnemonic: "CMP", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ZX:      {Mnemonic: "DEC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).dec},
+	CMP_ZINDLY:  {Mnemonic: "CMP", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	CLD:         {Mnemonic: "CLD", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).cld},
+	CMP_ABSY:    {Mnemonic: "CMP", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	PHX:         {Mnemonic: "PHX", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).phx},
+	STP:         {Mnemonic: "STP", Mode: Implied, Bytes: 1, Cycles: 3, execute: (*SixFiveOTwo).stp},
+	JML_INDL:    {Mnemonic: "JML", Mode: AbsoluteIndirectLong, Bytes: 3, Cycles: 6},
+	CMP_ABSX:    {Mnemonic: "CMP", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cmp},
+	DEC_ABSX:    {Mnemonic: "DEC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).dec},
+	CMP_ABSLX:   {Mnemonic: "CMP", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+	CPX_I:       {Mnemonic: "CPX", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).cpx},
+	SBC_INDX:    {Mnemonic: "SBC", Mode: IndexedIndirect, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).sbc},
+	SEP:         {Mnemonic: "SEP", Mode: Immediate, Bytes: 2, Cycles: 3},
+	SBC_SR:      {Mnemonic: "SBC", Mode: StackRelative, Bytes: 2, Cycles: 4},
+	CPX_Z:       {Mnemonic: "CPX", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).cpx},
+	SBC_Z:       {Mnemonic: "SBC", Mode: ZeroPage, Bytes: 2, Cycles: 3, execute: (*SixFiveOTwo).sbc},
+	INC_Z:       {Mnemonic: "INC", Mode: ZeroPage, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).inc},
+	SBC_ZINDL:   {Mnemonic: "SBC", Mode: ZeroPageIndirectLong, Bytes: 2, Cycles: 6},
+	INX:         {Mnemonic: "INX", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).inx},
+	SBC_I:       {Mnemonic: "SBC", Mode: Immediate, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).sbc},
+	NOP:         {Mnemonic: "NOP", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).nop},
+	XBA:         {Mnemonic: "XBA", Mode: Implied, Bytes: 1, Cycles: 3},
+	CPX_ABS:     {Mnemonic: "CPX", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).cpx},
+	SBC_ABS:     {Mnemonic: "SBC", Mode: Absolute, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ABS:     {Mnemonic: "INC", Mode: Absolute, Bytes: 3, Cycles: 6, execute: (*SixFiveOTwo).inc},
+	SBC_ABSL:    {Mnemonic: "SBC", Mode: AbsoluteLong, Bytes: 4, Cycles: 5},
+	BEQ:         {Mnemonic: "BEQ", Mode: Relative, Bytes: 2, Cycles: 2, execute: (*SixFiveOTwo).beq},
+	SBC_INDY:    {Mnemonic: "SBC", Mode: IndirectIndexed, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sbc},
+	SBC_ZIND:    {Mnemonic: "SBC", Mode: ZeroPageIndirect, Bytes: 2, Cycles: 5, execute: (*SixFiveOTwo).sbc},
+	SBC_SRINDY:  {Mnemonic: "SBC", Mode: StackRelativeIndirectY, Bytes: 2, Cycles: 7},
+	PEA:         {Mnemonic: "PEA", Mode: Absolute, Bytes: 3, Cycles: 5},
+	SBC_ZX:      {Mnemonic: "SBC", Mode: ZeroPageX, Bytes: 2, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ZX:      {Mnemonic: "INC", Mode: ZeroPageX, Bytes: 2, Cycles: 6, execute: (*SixFiveOTwo).inc},
+	SBC_ZINDLY:  {Mnemonic: "SBC", Mode: ZeroPageIndirectLongY, Bytes: 2, Cycles: 6},
+	SED:         {Mnemonic: "SED", Mode: Implied, Bytes: 1, Cycles: 2, execute: (*SixFiveOTwo).sed},
+	SBC_ABSY:    {Mnemonic: "SBC", Mode: AbsoluteY, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	PLX:         {Mnemonic: "PLX", Mode: Implied, Bytes: 1, Cycles: 4, execute: (*SixFiveOTwo).plx},
+	XCE:         {Mnemonic: "XCE", Mode: Implied, Bytes: 1, Cycles: 2},
+	JSR_INDABSX: {Mnemonic: "JSR", Mode: AbsoluteIndexedIndirect, Bytes: 3, Cycles: 8},
+	SBC_ABSX:    {Mnemonic: "SBC", Mode: AbsoluteX, Bytes: 3, Cycles: 4, execute: (*SixFiveOTwo).sbc},
+	INC_ABSX:    {Mnemonic: "INC", Mode: AbsoluteX, Bytes: 3, Cycles: 7, execute: (*SixFiveOTwo).inc},
+	SBC_ABSLX:   {Mnemonic: "SBC", Mode: AbsoluteLongX, Bytes: 4, Cycles: 5},
+}
+
+// w65816Handlers holds the handlers of w65816Opcodes.
+var w65816Handlers = [256]func(cpu *SixFiveEightSixteen, mem Memory24, op longOperand){
+	BRK:         (*SixFiveEightSixteen).brk,
+	ORA_INDX:    (*SixFiveEightSixteen).ora,
+	COP:         (*SixFiveEightSixteen).cop,
+	ORA_SR:      (*SixFiveEightSixteen).ora,
+	TSB_Z:       (*SixFiveEightSixteen).tsb,
+	ORA_Z:       (*SixFiveEightSixteen).ora,
+	ASL_Z:       (*SixFiveEightSixteen).asl,
+	ORA_ZINDL:   (*SixFiveEightSixteen).ora,
+	PHP:         (*SixFiveEightSixteen).php,
+	ORA_I:       (*SixFiveEightSixteen).ora,
+	ASL_A:       (*SixFiveEightSixteen).asl,
+	PHD:         (*SixFiveEightSixteen).phd,
+	TSB_ABS:     (*SixFiveEightSixteen).tsb,
+	ORA_ABS:     (*SixFiveEightSixteen).ora,
+	ASL_ABS:     (*SixFiveEightSixteen).asl,
+	ORA_ABSL:    (*SixFiveEightSixteen).ora,
+	BPL:         (*SixFiveEightSixteen).bpl,
+	ORA_INDY:    (*SixFiveEightSixteen).ora,
+	ORA_ZIND:    (*SixFiveEightSixteen).ora,
+	ORA_SRINDY:  (*SixFiveEightSixteen).ora,
+	TRB_Z:       (*SixFiveEightSixteen).trb,
+	ORA_ZX:      (*SixFiveEightSixteen).ora,
+	ASL_ZX:      (*SixFiveEightSixteen).asl,
+	ORA_ZINDLY:  (*SixFiveEightSixteen).ora,
+	CLC:         (*SixFiveEightSixteen).clc,
+	ORA_ABSY:    (*SixFiveEightSixteen).ora,
+	INC_A:       (*SixFiveEightSixteen).inc,
+	TCS:         (*SixFiveEightSixteen).tcs,
+	TRB_ABS:     (*SixFiveEightSixteen).trb,
+	ORA_ABSX:    (*SixFiveEightSixteen).ora,
+	ASL_ABSX:    (*SixFiveEightSixteen).asl,
+	ORA_ABSLX:   (*SixFiveEightSixteen).ora,
+	JSR_ABS:     (*SixFiveEightSixteen).jsr,
+	AND_INDX:    (*SixFiveEightSixteen).and,
+	JSL_ABSL:    (*SixFiveEightSixteen).jsl,
+	AND_SR:      (*SixFiveEightSixteen).and,
+	BIT_Z:       (*SixFiveEightSixteen).bit,
+	AND_Z:       (*SixFiveEightSixteen).and,
+	ROL_Z:       (*SixFiveEightSixteen).rol,
+	AND_ZINDL:   (*SixFiveEightSixteen).and,
+	PLP:         (*SixFiveEightSixteen).plp,
+	AND_I:       (*SixFiveEightSixteen).and,
+	ROL_A:       (*SixFiveEightSixteen).rol,
+	PLD:         (*SixFiveEightSixteen).pld,
+	BIT_ABS:     (*SixFiveEightSixteen).bit,
+	AND_ABS:     (*SixFiveEightSixteen).and,
+	ROL_ABS:     (*SixFiveEightSixteen).rol,
+	AND_ABSL:    (*SixFiveEightSixteen).and,
+	BMI:         (*SixFiveEightSixteen).bmi,
+	AND_INDY:    (*SixFiveEightSixteen).and,
+	AND_ZIND:    (*SixFiveEightSixteen).and,
+	AND_SRINDY:  (*SixFiveEightSixteen).and,
+	BIT_ZX:      (*SixFiveEightSixteen).bit,
+	AND_ZX:      (*SixFiveEightSixteen).and,
+	ROL_ZX:      (*SixFiveEightSixteen).rol,
+	AND_ZINDLY:  (*SixFiveEightSixteen).and,
+	SEC:         (*SixFiveEightSixteen).sec,
+	AND_ABSY:    (*SixFiveEightSixteen).and,
+	DEC_A:       (*SixFiveEightSixteen).dec,
+	TSC:         (*SixFiveEightSixteen).tsc,
+	BIT_ABSX:    (*SixFiveEightSixteen).bit,
+	AND_ABSX:    (*SixFiveEightSixteen).and,
+	ROL_ABSX:    (*SixFiveEightSixteen).rol,
+	AND_ABSLX:   (*SixFiveEightSixteen).and,
+	RTI:         (*SixFiveEightSixteen).rti,
+	EOR_INDX:    (*SixFiveEightSixteen).eor,
+	WDM:         (*SixFiveEightSixteen).nop,
+	EOR_SR:      (*SixFiveEightSixteen).eor,
+	MVP:         (*SixFiveEightSixteen).mvp,
+	EOR_Z:       (*SixFiveEightSixteen).eor,
+	LSR_Z:       (*SixFiveEightSixteen).lsr,
+	EOR_ZINDL:   (*SixFiveEightSixteen).eor,
+	PHA:         (*SixFiveEightSixteen).pha,
+	EOR_I:       (*SixFiveEightSixteen).eor,
+	LSR_A:       (*SixFiveEightSixteen).lsr,
+	PHK:         (*SixFiveEightSixteen).phk,
+	JMP_ABS:     (*SixFiveEightSixteen).jmp,
+	EOR_ABS:     (*SixFiveEightSixteen).eor,
+	LSR_ABS:     (*SixFiveEightSixteen).lsr,
+	EOR_ABSL:    (*SixFiveEightSixteen).eor,
+	BVC:         (*SixFiveEightSixteen).bvc,
+	EOR_INDY:    (*SixFiveEightSixteen).eor,
+	EOR_ZIND:    (*SixFiveEightSixteen).eor,
+	EOR_SRINDY:  (*SixFiveEightSixteen).eor,
+	MVN:         (*SixFiveEightSixteen).mvn,
+	EOR_ZX:      (*SixFiveEightSixteen).eor,
+	LSR_ZX:      (*SixFiveEightSixteen).lsr,
+	EOR_ZINDLY:  (*SixFiveEightSixteen).eor,
+	CLI:         (*SixFiveEightSixteen).cli,
+	EOR_ABSY:    (*SixFiveEightSixteen).eor,
+	PHY:         (*SixFiveEightSixteen).phy,
+	TCD:         (*SixFiveEightSixteen).tcd,
+	JML_ABSL:    (*SixFiveEightSixteen).jmp,
+	EOR_ABSX:    (*SixFiveEightSixteen).eor,
+	LSR_ABSX:    (*SixFiveEightSixteen).lsr,
+	EOR_ABSLX:   (*SixFiveEightSixteen).eor,
+	RTS:         (*SixFiveEightSixteen).rts,
+	ADC_INDX:    (*SixFiveEightSixteen).adc,
+	PER:         (*SixFiveEightSixteen).per,
+	ADC_SR:      (*SixFiveEightSixteen).adc,
+	STZ_Z:       (*SixFiveEightSixteen).stz,
+	ADC_Z:       (*SixFiveEightSixteen).adc,
+	ROR_Z:       (*SixFiveEightSixteen).ror,
+	ADC_ZINDL:   (*SixFiveEightSixteen).adc,
+	PLA:         (*SixFiveEightSixteen).pla,
+	ADC_I:       (*SixFiveEightSixteen).adc,
+	ROR_A:       (*SixFiveEightSixteen).ror,
+	RTL:         (*SixFiveEightSixteen).rtl,
+	JMP_IND:     (*SixFiveEightSixteen).jmp,
+	ADC_ABS:     (*SixFiveEightSixteen).adc,
+	ROR_ABS:     (*SixFiveEightSixteen).ror,
+	ADC_ABSL:    (*SixFiveEightSixteen).adc,
+	BVS:         (*SixFiveEightSixteen).bvs,
+	ADC_INDY:    (*SixFiveEightSixteen).adc,
+	ADC_ZIND:    (*SixFiveEightSixteen).adc,
+	ADC_SRINDY:  (*SixFiveEightSixteen).adc,
+	STZ_ZX:      (*SixFiveEightSixteen).stz,
+	ADC_ZX:      (*SixFiveEightSixteen).adc,
+	ROR_ZX:      (*SixFiveEightSixteen).ror,
+	ADC_ZINDLY:  (*SixFiveEightSixteen).adc,
+	SEI:         (*SixFiveEightSixteen).sei,
+	ADC_ABSY:    (*SixFiveEightSixteen).adc,
+	PLY:         (*SixFiveEightSixteen).ply,
+	TDC:         (*SixFiveEightSixteen).tdc,
+	JMP_INDABSX: (*SixFiveEightSixteen).jmp,
+	ADC_ABSX:    (*SixFiveEightSixteen).adc,
+	ROR_ABSX:    (*SixFiveEightSixteen).ror,
+	ADC_ABSLX:   (*SixFiveEightSixteen).adc,
+	BRA:         (*SixFiveEightSixteen).bra,
+	STA_INDX:    (*SixFiveEightSixteen).sta,
+	BRL:         (*SixFiveEightSixteen).brl,
+	STA_SR:      (*SixFiveEightSixteen).sta,
+	STY_Z:       (*SixFiveEightSixteen).sty,
+	STA_Z:       (*SixFiveEightSixteen).sta,
+	STX_Z:       (*SixFiveEightSixteen).stx,
+	STA_ZINDL:   (*SixFiveEightSixteen).sta,
+	DEY:         (*SixFiveEightSixteen).dey,
+	BIT_I:       (*SixFiveEightSixteen).bit,
+	TXA:         (*SixFiveEightSixteen).txa,
+	PHB:         (*SixFiveEightSixteen).phb,
+	STY_ABS:     (*SixFiveEightSixteen).sty,
+	STA_ABS:     (*SixFiveEightSixteen).sta,
+	STX_ABS:     (*SixFiveEightSixteen).stx,
+	STA_ABSL:    (*SixFiveEightSixteen).sta,
+	BCC:         (*SixFiveEightSixteen).bcc,
+	STA_INDY:    (*SixFiveEightSixteen).sta,
+	STA_ZIND:    (*SixFiveEightSixteen).sta,
+	STA_SRINDY:  (*SixFiveEightSixteen).sta,
+	STY_ZX:      (*SixFiveEightSixteen).sty,
+	STA_ZX:      (*SixFiveEightSixteen).sta,
+	STX_ZY:      (*SixFiveEightSixteen).stx,
+	STA_ZINDLY:  (*SixFiveEightSixteen).sta,
+	TYA:         (*SixFiveEightSixteen).tya,
+	STA_ABSY:    (*SixFiveEightSixteen).sta,
+	TXS:         (*SixFiveEightSixteen).txs,
+	TXY:         (*SixFiveEightSixteen).txy,
+	STZ_ABS:     (*SixFiveEightSixteen).stz,
+	STA_ABSX:    (*SixFiveEightSixteen).sta,
+	STZ_ABSX:    (*SixFiveEightSixteen).stz,
+	STA_ABSLX:   (*SixFiveEightSixteen).sta,
+	LDY_I:       (*SixFiveEightSixteen).ldy,
+	LDA_INDX:    (*SixFiveEightSixteen).lda,
+	LDX_I:       (*SixFiveEightSixteen).ldx,
+	LDA_SR:      (*SixFiveEightSixteen).lda,
+	LDY_Z:       (*SixFiveEightSixteen).ldy,
+	LDA_Z:       (*SixFiveEightSixteen).lda,
+	LDX_Z:       (*SixFiveEightSixteen).ldx,
+	LDA_ZINDL:   (*SixFiveEightSixteen).lda,
+	TAY:         (*SixFiveEightSixteen).tay,
+	LDA_I:       (*SixFiveEightSixteen).lda,
+	TAX:         (*SixFiveEightSixteen).tax,
+	PLB:         (*SixFiveEightSixteen).plb,
+	LDY_ABS:     (*SixFiveEightSixteen).ldy,
+	LDA_ABS:     (*SixFiveEightSixteen).lda,
+	LDX_ABS:     (*SixFiveEightSixteen).ldx,
+	LDA_ABSL:    (*SixFiveEightSixteen).lda,
+	BCS:         (*SixFiveEightSixteen).bcs,
+	LDA_INDY:    (*SixFiveEightSixteen).lda,
+	LDA_ZIND:    (*SixFiveEightSixteen).lda,
+	LDA_SRINDY:  (*SixFiveEightSixteen).lda,
+	LDY_ZX:      (*SixFiveEightSixteen).ldy,
+	LDA_ZX:      (*SixFiveEightSixteen).lda,
+	LDX_ZY:      (*SixFiveEightSixteen).ldx,
+	LDA_ZINDLY:  (*SixFiveEightSixteen).lda,
+	CLV:         (*SixFiveEightSixteen).clv,
+	LDA_ABSY:    (*SixFiveEightSixteen).lda,
+	TSX:         (*SixFiveEightSixteen).tsx,
+	TYX:         (*SixFiveEightSixteen).tyx,
+	LDY_ABSX:    (*SixFiveEightSixteen).ldy,
+	LDA_ABSX:    (*SixFiveEightSixteen).lda,
+	LDX_ABSY:    (*SixFiveEightSixteen).ldx,
+	LDA_ABSLX:   (*SixFiveEightSixteen).lda,
+	CPY_I:       (*SixFiveEightSixteen).cpy,
+	CMP_INDX:    (*SixFiveEightSixteen).cmp,
+	REP:         (*SixFiveEightSixteen).rep,
+	CMP_SR:      (*SixFiveEightSixteen).cmp,
+	CPY_Z:       (*SixFiveEightSixteen).cpy,
+	CMP_Z:       (*SixFiveEightSixteen).cmp,
+	DEC_Z:       (*SixFiveEightSixteen).dec,
+	CMP_ZINDL:   (*SixFiveEightSixteen).cmp,
+	INY:         (*SixFiveEightSixteen).iny,
+	CMP_I:       (*SixFiveEightSixteen).cmp,
+	DEX:         (*SixFiveEightSixteen).dex,
+	WAI:         (*SixFiveEightSixteen).wai,
+	CPY_ABS:     (*SixFiveEightSixteen).cpy,
+	CMP_ABS:     (*SixFiveEightSixteen).cmp,
+	DEC_ABS:     (*SixFiveEightSixteen).dec,
+	CMP_ABSL:    (*SixFiveEightSixteen).cmp,
+	BNE:         (*SixFiveEightSixteen).bne,
+	CMP_INDY:    (*SixFiveEightSixteen).cmp,
+	CMP_ZIND:    (*SixFiveEightSixteen).cmp,
+	CMP_SRINDY:  (*SixFiveEightSixteen).cmp,
+	PEI:         (*SixFiveEightSixteen).pei,
+	CMP_ZX:      (*SixFiveEightSixteen).cmp,
+	DEC_ZX:      (*SixFiveEightSixteen).dec,
+	CMP_ZINDLY:  (*SixFiveEightSixteen).cmp,
+	CLD:         (*SixFiveEightSixteen).cld,
+	CMP_ABSY:    (*SixFiveEightSixteen).cmp,
+	PHX:         (*SixFiveEightSixteen).phx,
+	STP:         (*SixFiveEightSixteen).stp,
+	JML_INDL:    (*SixFiveEightSixteen).jmp,
+	CMP_ABSX:    (*SixFiveEightSixteen).cmp,
+	DEC_ABSX:    (*SixFiveEightSixteen).dec,
+	CMP_ABSLX:   (*SixFiveEightSixteen).cmp,
+	CPX_I:       (*SixFiveEightSixteen).cpx,
+	SBC_INDX:    (*SixFiveEightSixteen).sbc,
+	SEP:         (*SixFiveEightSixteen).sep,
+	SBC_SR:      (*SixFiveEightSixteen).sbc,
+	CPX_Z:       (*SixFiveEightSixteen).cpx,
+	SBC_Z:       (*SixFiveEightSixteen).sbc,
+	INC_Z:       (*SixFiveEightSixteen).inc,
+	SBC_ZINDL:   (*SixFiveEightSixteen).sbc,
+	INX:         (*SixFiveEightSixteen).inx,
+	SBC_I:       (*SixFiveEightSixteen).sbc,
+	NOP:         (*SixFiveEightSixteen).nop,
+	XBA:         (*SixFiveEightSixteen).xba,
+	CPX_ABS:     (*SixFiveEightSixteen).cpx,
+	SBC_ABS:     (*SixFiveEightSixteen).sbc,
+	INC_ABS:     (*SixFiveEightSixteen).inc,
+	SBC_ABSL:    (*SixFiveEightSixteen).sbc,
+	BEQ:         (*SixFiveEightSixteen).beq,
+	SBC_INDY:    (*SixFiveEightSixteen).sbc,
+	SBC_ZIND:    (*SixFiveEightSixteen).sbc,
+	SBC_SRINDY:  (*SixFiveEightSixteen).sbc,
+	PEA:         (*SixFiveEightSixteen).pea,
+	SBC_ZX:      (*SixFiveEightSixteen).sbc,
+	INC_ZX:      (*SixFiveEightSixteen).inc,
+	SBC_ZINDLY:  (*SixFiveEightSixteen).sbc,
+	SED:         (*SixFiveEightSixteen).sed,
+	SBC_ABSY:    (*SixFiveEightSixteen).sbc,
+	PLX:         (*SixFiveEightSixteen).plx,
+	XCE:         (*SixFiveEightSixteen).xce,
+	JSR_INDABSX: (*SixFiveEightSixteen).jsr,
+	SBC_ABSX:    (*SixFiveEightSixteen).sbc,
+	INC_ABSX:    (*SixFiveEightSixteen).inc,
+	SBC_ABSLX:   (*SixFiveEightSixteen).sbc,
+}
@@ -0,0 +1,220 @@
+package computer
+
+import "fmt"
+
+// Mirror repeats a smaller Device across a larger region of a MemoryMap, like a 2K RAM chip in an 8K
+// window whose upper address lines are not decoded.
+type Mirror struct {
+	device Device
+	size   int
+}
+
+// NewMirror repeats the first size bytes of the Device across the region it is mapped to.
+// The size has to be positive and must not exceed the Device if it is a Sizer.
+func NewMirror(device Device, size int) (*Mirror, error) {
+	if device == nil {
+		return nil, &DeviceError{Device: "mirror", Reason: "no device"}
+	}
+	if size <= 0 {
+		return nil, &DeviceError{Device: "mirror", Reason: fmt.Sprintf("size %d is not positive", size)}
+	}
+	if sizer, ok := device.(Sizer); ok && sizer.Size() < size {
+		return nil, &DeviceError{Device: "mirror", Reason: fmt.Sprintf("the device has only %#04x bytes", sizer.Size())}
+	}
+	return &Mirror{device: device, size: size}, nil
+}
+
+// Size returns the size of the address space, the repeated Device covers any region. See Sizer.
+func (m *Mirror) Size() int {
+	return 0x10000
+}
+
+// target returns the mirrored Device and the offset inside of it, see forwarder.
+func (m *Mirror) target(offset Address) (Device, Address) {
+	return m.device, Address(int(offset) % m.size)
+}
+
+func (m *Mirror) Read(offset Address) Word {
+	return m.device.Read(Address(int(offset) % m.size))
+}
+
+func (m *Mirror) Write(offset Address, value Word) {
+	m.device.Write(Address(int(offset)%m.size), value)
+}
+
+// Init initializes the mirrored Device if it has an Init method.
+func (m *Mirror) Init() error {
+	if device, ok := m.device.(interface{ Init() error }); ok {
+		return device.Init()
+	}
+	return nil
+}
+
+// Fault returns the fault of the mirrored Device, see FaultReporter.
+func (m *Mirror) Fault() error {
+	return memoryFault(m.device)
+}
+
+// Snapshot returns the state of the mirrored Device, see Snapshotter.
+func (m *Mirror) Snapshot() any {
+	return snapshotDevice(m.device)
+}
+
+// Restore restores the state of the mirrored Device, see Snapshotter.
+func (m *Mirror) Restore(state any) error {
+	return restoreDevice(m.device, state)
+}
+
+func (m *Mirror) String() string {
+	return fmt.Sprintf("%T mirrored every %#04x bytes", m.device, m.size)
+}
+
+// BankSwitch maps one of several banks of the same size into its region of a MemoryMap. The bank is
+// selected by writing its number to the latch register, see Latch.
+type BankSwitch struct {
+	banks []Device
+	bank  int
+}
+
+// NewBankSwitch returns a BankSwitch with the first bank selected, it needs at least one bank.
+func NewBankSwitch(banks ...Device) (*BankSwitch, error) {
+	if len(banks) == 0 {
+		return nil, &DeviceError{Device: "bank switch", Reason: "no banks"}
+	}
+	for i, bank := range banks {
+		if bank == nil {
+			return nil, &DeviceError{Device: "bank switch", Reason: fmt.Sprintf("bank %d is no device", i)}
+		}
+	}
+	return &BankSwitch{banks: banks}, nil
+}
+
+// Bank returns the number of the selected bank.
+func (b *BankSwitch) Bank() int {
+	return b.bank
+}
+
+// Banks returns the number of banks.
+func (b *BankSwitch) Banks() int {
+	return len(b.banks)
+}
+
+// SelectBank selects the bank. Like a latch with fewer bits than the value, the number wraps around,
+// negative numbers count back from the last bank.
+func (b *BankSwitch) SelectBank(bank int) {
+	n := len(b.banks)
+	b.bank = (bank%n + n) % n
+}
+
+// Latch returns the latch register as a Device of its own, boards decode it at a different address than
+// the banks. Writing to it selects the bank, reading it returns the number of the selected bank.
+func (b *BankSwitch) Latch() Device {
+	return bankLatch{b}
+}
+
+// Size returns the size of the smallest bank that is a Sizer, or the size of the address space if no bank
+// is one. See Sizer.
+func (b *BankSwitch) Size() int {
+	size := 0x10000
+	for _, bank := range b.banks {
+		if sizer, ok := bank.(Sizer); ok {
+			size = min(size, sizer.Size())
+		}
+	}
+	return size
+}
+
+// target returns the selected bank, see forwarder.
+func (b *BankSwitch) target(offset Address) (Device, Address) {
+	return b.banks[b.bank], offset
+}
+
+func (b *BankSwitch) Read(offset Address) Word {
+	return b.banks[b.bank].Read(offset)
+}
+
+func (b *BankSwitch) Write(offset Address, value Word) {
+	b.banks[b.bank].Write(offset, value)
+}
+
+// Init initializes the banks that have an Init method and selects the first bank.
+func (b *BankSwitch) Init() error {
+	for _, bank := range b.banks {
+		if device, ok := bank.(interface{ Init() error }); ok {
+			if err := device.Init(); err != nil {
+				return err
+			}
+		}
+	}
+	b.bank = 0
+	return nil
+}
+
+// Fault returns the first fault of the banks that are a FaultReporter, see FaultReporter. All banks are
+// asked, a bank may have faulted before another one was selected.
+func (b *BankSwitch) Fault() error {
+	var fault error
+	for _, bank := range b.banks {
+		if err := memoryFault(bank); err != nil && fault == nil {
+			fault = err
+		}
+	}
+	return fault
+}
+
+// bankSwitchState is the Snapshot of a BankSwitch
+type bankSwitchState struct {
+	bank  int
+	banks []any
+}
+
+// Snapshot returns the selected bank and the state of all banks, see Snapshotter.
+func (b *BankSwitch) Snapshot() any {
+	state := bankSwitchState{bank: b.bank, banks: make([]any, len(b.banks))}
+	for i, bank := range b.banks {
+		state.banks[i] = snapshotDevice(bank)
+	}
+	return state
+}
+
+// Restore selects the bank of the Snapshot and restores the state of all banks, see Snapshotter.
+func (b *BankSwitch) Restore(state any) error {
+	s, ok := state.(bankSwitchState)
+	if !ok || len(s.banks) != len(b.banks) {
+		return &SnapshotError{Target: b, State: state}
+	}
+	for i, bank := range b.banks {
+		if err := restoreDevice(bank, s.banks[i]); err != nil {
+			return err
+		}
+	}
+	b.bank = s.bank
+	return nil
+}
+
+func (b *BankSwitch) String() string {
+	return fmt.Sprintf("bank %d of %d", b.bank, len(b.banks))
+}
+
+// bankLatch is the latch register of a BankSwitch
+type bankLatch struct {
+	bankSwitch *BankSwitch
+}
+
+func (l bankLatch) Read(_ Address) Word {
+	return Word(l.bankSwitch.bank)
+}
+
+func (l bankLatch) Write(_ Address, value Word) {
+	l.bankSwitch.SelectBank(int(value))
+}
+
+// Peek returns the number of the selected bank, reading the latch has no side effects.
+func (l bankLatch) Peek(offset Address) Word {
+	return l.Read(offset)
+}
+
+// Poke selects the bank like the CPU would, the bank is the value of the latch.
+func (l bankLatch) Poke(offset Address, value Word) {
+	l.Write(offset, value)
+}
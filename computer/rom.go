@@ -0,0 +1,99 @@
+package computer
+
+import "fmt"
+
+// ROMWritePolicy decides what happens when the CPU writes to a ROM.
+type ROMWritePolicy uint8
+
+const (
+	// IgnoreROMWrites drops the write like the hardware does. This is the default.
+	IgnoreROMWrites ROMWritePolicy = iota
+	// CallbackOnROMWrite drops the write and passes a *ROMWriteError to ROM.OnWrite.
+	CallbackOnROMWrite
+	// HaltOnROMWrite drops the write and stops execution, Step returns the *ROMWriteError
+	// after the instruction that wrote to the ROM.
+	HaltOnROMWrite
+)
+
+// ROMWriteError describes a write to a ROM, see ROMWritePolicy.
+type ROMWriteError struct {
+	// Offset is the offset of the write inside the ROM
+	Offset Address
+	Value  Word
+}
+
+func (e *ROMWriteError) Error() string {
+	return fmt.Sprintf("write of %s to ROM offset %s", e.Value, e.Offset)
+}
+
+// ROM is a read only Device loaded from an image, writes never change it.
+type ROM struct {
+	data []Word
+
+	// Policy decides what happens on a write, the write is dropped in any case
+	Policy ROMWritePolicy
+	// OnWrite is called for writes if the Policy is CallbackOnROMWrite
+	OnWrite func(err *ROMWriteError)
+
+	// fault is the write HaltOnROMWrite reports through Fault
+	fault *ROMWriteError
+}
+
+// NewROM returns a ROM with a copy of the image.
+func NewROM(image []byte) *ROM {
+	data := make([]Word, len(image))
+	for i, b := range image {
+		data[i] = Word(b)
+	}
+	return &ROM{data: data}
+}
+
+// Size returns the size of the ROM in bytes, see Sizer.
+func (r *ROM) Size() int {
+	return len(r.data)
+}
+
+// index returns the index of the offset in the image. Like a ROM in a larger socket whose upper address
+// lines are not connected, offsets beyond the image wrap around. MemoryMap.Map rejects such regions though,
+// use a Mirror to repeat a ROM on purpose.
+func (r *ROM) index(offset Address) int {
+	return int(offset) % len(r.data)
+}
+
+func (r *ROM) Read(offset Address) Word {
+	return r.data[r.index(offset)]
+}
+
+func (r *ROM) Write(offset Address, value Word) {
+	err := &ROMWriteError{Offset: offset, Value: value}
+	switch r.Policy {
+	case CallbackOnROMWrite:
+		if r.OnWrite != nil {
+			r.OnWrite(err)
+		}
+	case HaltOnROMWrite:
+		if r.fault == nil {
+			r.fault = err
+		}
+	}
+}
+
+// Peek returns the value at the offset, see Peeker.
+func (r *ROM) Peek(offset Address) Word {
+	return r.data[r.index(offset)]
+}
+
+// Poke patches the image, a debugger can change the ROM unlike the CPU. See Peeker.
+func (r *ROM) Poke(offset Address, value Word) {
+	r.data[r.index(offset)] = value
+}
+
+// Fault returns the first write since the last call if the Policy is HaltOnROMWrite, see FaultReporter.
+func (r *ROM) Fault() error {
+	if r.fault == nil {
+		return nil
+	}
+	err := r.fault
+	r.fault = nil
+	return err
+}
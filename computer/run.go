@@ -0,0 +1,209 @@
+package computer
+
+import (
+	"context"
+	"strings"
+)
+
+// Step runs a single instruction and reports the number of cycles it used.
+//
+// Every instruction is decoded through the opcode table (see LookupOpcode). Interrupts are polled
+// before the instruction is fetched, if one is pending the step runs the interrupt sequence instead.
+// An illegal opcode is handled according to the IllegalOpcodePolicy.
+// Once a JAM opcode halted the CPU every step returns the *JamError without using a cycle until Reset is called.
+// While the CPU waits for an interrupt after WAI every step takes a single cycle.
+// An instruction that Tick left in the middle is finished first, its cycles are not part of the result.
+// A fault of the Memory (see FaultReporter) is returned after the instruction that caused it.
+// If VerifyCycles is set an instruction that took a different number of cycles than the opcode table allows for
+// returns a *CycleVerificationError.
+func (cpu *SixFiveOTwo) Step(mem Memory) (uint, error) {
+	cpu.Stop()
+	return cpu.step(mem)
+}
+
+// step implements Step, it is also run by Tick.
+func (cpu *SixFiveOTwo) step(mem Memory) (uint, error) {
+	if cpu.jammed != nil {
+		return 0, cpu.jammed
+	}
+	start := cpu.Cycle
+	cpu.instructionAddress = cpu.ProgramCounter
+	if cpu.waiting {
+		if !cpu.nmiPending && !cpu.irq {
+			cpu.programRead(mem, cpu.ProgramCounter)
+			return cpu.Cycle - start, nil
+		}
+		cpu.waiting = false
+	}
+	if cpu.serviceInterrupts(mem) {
+		return cpu.Cycle - start, nil
+	}
+
+	instruction := cpu.FetchInstruction(mem)
+	cpu.logger.LogE("%s\n", instruction)
+
+	opcode := cpu.lookupOpcode(instruction)
+	if opcode.execute == nil {
+		err := cpu.illegalOpcode(mem, instruction)
+		return cpu.Cycle - start, err
+	}
+	op := cpu.executeOpcode(mem, instruction, opcode)
+	if cpu.jammed != nil {
+		cpu.jammed.Opcode = instruction
+		cpu.logger.LogE("%s\n", cpu.jammed)
+		return cpu.Cycle - start, cpu.jammed
+	}
+	if err := memoryFault(mem); err != nil {
+		cpu.logger.LogE("%s\n", err)
+		return cpu.Cycle - start, err
+	}
+	if !cpu.VerifyCycles {
+		return cpu.Cycle - start, nil
+	}
+	if expected := cpu.expectedCycles(mem, opcode, op); cpu.Cycle-start != expected {
+		err := &CycleVerificationError{ProgramCounter: cpu.instructionAddress, Opcode: instruction, Expected: expected, Actual: cpu.Cycle - start}
+		cpu.logger.LogE("%s\n", err)
+		return cpu.Cycle - start, err
+	}
+	return cpu.Cycle - start, nil
+}
+
+// executeOpcode resolves the operand of a fetched opcode and runs its handler, it returns the operand for expectedCycles.
+func (cpu *SixFiveOTwo) executeOpcode(mem Memory, instruction Instruction, opcode Opcode) operand {
+	op := operand{mode: opcode.Mode}
+	switch {
+	case opcode.Cycles == 1:
+		// The reserved single cycle NOPs of the 65C02 do not even read the byte after the opcode
+	case instruction == JSR_ABS:
+		// JSR fetches the high byte of its target after pushing the return address, see jsr
+	default:
+		op = cpu.resolve(mem, opcode.Mode)
+	}
+	opcode.execute(cpu, mem, op)
+	return op
+}
+
+// operandAccess is the way the instructions with an indexed addressing mode use their operand, the
+// instructions that are missing read it. expectedCycles looks up the indexTiming table with it.
+var operandAccess = map[string]access{
+	"STA": writeAccess, "STX": writeAccess, "STY": writeAccess, "STZ": writeAccess,
+	"SAX": writeAccess, "SHA": writeAccess, "SHX": writeAccess, "SHY": writeAccess, "TAS": writeAccess,
+	"ASL": modifyAccess, "LSR": modifyAccess, "ROL": modifyAccess, "ROR": modifyAccess,
+	"SLO": modifyAccess, "RLA": modifyAccess, "SRE": modifyAccess, "RRA": modifyAccess,
+	"DCP": modifyAccess, "ISC": modifyAccess,
+	"INC": incrementAccess, "DEC": incrementAccess,
+}
+
+// zeroPageModes are the addressing modes that fetch a zero page offset, see fetchZeroPage.
+var zeroPageModes = map[AddressingMode]bool{
+	ZeroPage: true, ZeroPageX: true, ZeroPageY: true, IndexedIndirect: true, IndirectIndexed: true,
+	ZeroPageIndirect: true, ZeroPageRelative: true,
+}
+
+// branchConditions are the flag and its value the conditional branches test.
+var branchConditions = map[string]struct {
+	flag  uint8
+	value Word
+}{
+	"BCC": {CarryFlagPosition, 0}, "BCS": {CarryFlagPosition, 1},
+	"BNE": {ZeroFlagPosition, 0}, "BEQ": {ZeroFlagPosition, 1},
+	"BPL": {NegativeFlagPosition, 0}, "BMI": {NegativeFlagPosition, 1},
+	"BVC": {OverflowFlagPosition, 0}, "BVS": {OverflowFlagPosition, 1},
+}
+
+// expectedCycles returns the cycles the data sheets give for an executed instruction, see VerifyCycles.
+//
+// They are derived from the operand and the flags, not from the accesses the instruction made: the base
+// cycles of the opcode table, a cycle if indexing crossed a page and the indexTiming table charges for it,
+// a cycle for a taken branch and one more if the branch crossed a page, on the 65C02 a cycle for ADC and
+// SBC in decimal mode and on the 65816 a cycle for a DirectPage that is not page aligned (see fetchZeroPage).
+// Neither branches nor ADC and SBC change the flags they depend on.
+func (cpu *SixFiveOTwo) expectedCycles(mem Memory, opcode Opcode, op operand) uint {
+	expected := uint(opcode.Cycles)
+	if op.pageCrossed && cpu.penaltyOf(op, operandAccess[opcode.Mnemonic]) == pageCrossPenalty {
+		expected++
+	}
+	if (op.mode == Relative || op.mode == ZeroPageRelative) && cpu.branchTaken(mem, opcode.Mnemonic, op) {
+		// The opcode table already counts the taken branch of BRA
+		if opcode.Mnemonic != "BRA" {
+			expected++
+		}
+		if op.pageCrossed {
+			expected++
+		}
+	}
+	if cpu.Model.c02Timing() && cpu.Status.GetDecimalFlag() == 1 && (opcode.Mnemonic == "ADC" || opcode.Mnemonic == "SBC") {
+		expected++
+	}
+	if cpu.unalignedDirectPage() && zeroPageModes[op.mode] {
+		expected++
+	}
+	return expected
+}
+
+// branchTaken evaluates the condition of a branch. BBR and BBS test a bit of a zero page location, they
+// were taken if they continued at their target. If the target is the next instruction the bit that is part of
+// the mnemonic is peeked at instead, so the check has no side effects.
+func (cpu *SixFiveOTwo) branchTaken(mem Memory, mnemonic string, op operand) bool {
+	if condition, ok := branchConditions[mnemonic]; ok {
+		return cpu.Status.GetFlag(condition.flag) == condition.value
+	}
+	if mnemonic == "BRA" {
+		return true
+	}
+	if next := cpu.instructionAddress + 3; op.target != next {
+		return cpu.ProgramCounter == op.target
+	}
+	bit := mnemonic[3] - '0'
+	set := cpu.Peek(mem, op.address)&(1<<bit) != 0
+	return set == strings.HasPrefix(mnemonic, "BBS")
+}
+
+// RunCycles runs instructions for the given number of cycles.
+//
+// Instructions can not be interrupted, so the last instruction may run past the budget. These cycles are
+// remembered and subtracted from the budget of the next call, which keeps the CPU in step with a
+// host that calls RunCycles with a fixed number of cycles per frame or scanline.
+func (cpu *SixFiveOTwo) RunCycles(cycles uint, mem Memory) error {
+	if cpu.cycleDebt >= cycles {
+		cpu.cycleDebt -= cycles
+		return nil
+	}
+	budget := cycles - cpu.cycleDebt
+	cpu.cycleDebt = 0
+	for budget > 0 {
+		used, err := cpu.Step(mem)
+		if used > budget {
+			cpu.cycleDebt = used - budget
+			used = budget
+		}
+		budget -= used
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunUntil runs instructions until the predicate returns true. The predicate is checked before every instruction.
+func (cpu *SixFiveOTwo) RunUntil(mem Memory, predicate func(cpu *SixFiveOTwo) bool) error {
+	for !predicate(cpu) {
+		if _, err := cpu.Step(mem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunContext runs instructions until the context is done or an error occurs.
+// It returns the error of the context if it was cancelled.
+func (cpu *SixFiveOTwo) RunContext(ctx context.Context, mem Memory) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := cpu.Step(mem); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package computer
+
+// asl ASL - Arithmetic Shift Left
+//
+// This operation shifts all the bits of the accumulator or memory contents one bit left.
+// Bit 0 is set to 0 and bit 7 is placed in the carry flag.
+func (cpu *SixFiveOTwo) asl(mem Memory, op operand) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		cpu.Status.SetCarryFlag(value&bit7 != 0)
+		value <<= 1
+		cpu.evaluateAndSetStatusFlags(value)
+		return value
+	})
+}
+
+// lsr LSR - Logical Shift Right
+//
+// Each of the bits in A or M is shift one place to the right.
+// The bit that was in bit 0 is shifted into the carry flag. Bit 7 is set to zero.
+func (cpu *SixFiveOTwo) lsr(mem Memory, op operand) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		cpu.Status.SetCarryFlag(value&bit0 != 0)
+		value >>= 1
+		cpu.evaluateAndSetStatusFlags(value)
+		return value
+	})
+}
+
+// rol ROL - Rotate Left
+//
+// Move each of the bits in either A or M one place to the left.
+// Bit 0 is filled with the current value of the carry flag whilst the old bit 7 becomes the new carry flag value.
+func (cpu *SixFiveOTwo) rol(mem Memory, op operand) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		carry := cpu.Status.GetCarryFlag()
+		cpu.Status.SetCarryFlag(value&bit7 != 0)
+		value = value<<1 | carry
+		cpu.evaluateAndSetStatusFlags(value)
+		return value
+	})
+}
+
+// ror ROR - Rotate Right
+//
+// Move each of the bits in either A or M one place to the right.
+// Bit 7 is filled with the current value of the carry flag whilst the old bit 0 becomes the new carry flag value.
+func (cpu *SixFiveOTwo) ror(mem Memory, op operand) {
+	cpu.modifyOperand(mem, op, func(value Word) Word {
+		carry := cpu.Status.GetCarryFlag()
+		cpu.Status.SetCarryFlag(value&bit0 != 0)
+		value = value>>1 | carry<<7
+		cpu.evaluateAndSetStatusFlags(value)
+		return value
+	})
+}
@@ -0,0 +1,90 @@
+package computer
+
+import "slices"
+
+// Snapshotter is implemented by a Memory or Device that can save and restore its state, like the
+// content of a RAM or the selected bank of a BankSwitch. A ROM has no state that could change.
+type Snapshotter interface {
+	// Snapshot returns a copy of the state
+	Snapshot() any
+	// Restore sets the state from a Snapshot of the same Memory or Device, a *SnapshotError is returned for
+	// any other state
+	Restore(state any) error
+}
+
+// snapshotDevice returns the Snapshot of the Device or nil if it is no Snapshotter.
+func snapshotDevice(device Device) any {
+	if s, ok := device.(Snapshotter); ok {
+		return s.Snapshot()
+	}
+	return nil
+}
+
+// restoreDevice restores the Device if it is a Snapshotter.
+func restoreDevice(device Device, state any) error {
+	if s, ok := device.(Snapshotter); ok {
+		return s.Restore(state)
+	}
+	return nil
+}
+
+// memoryMapState is the Snapshot of a MemoryMap
+type memoryMapState struct {
+	regions []any
+	dataBus Word
+}
+
+// Snapshot returns the state of all mapped Devices and the open bus, see Snapshotter.
+func (m *MemoryMap) Snapshot() any {
+	state := memoryMapState{regions: make([]any, len(m.regions)), dataBus: m.dataBus}
+	for i, r := range m.regions {
+		state.regions[i] = snapshotDevice(r.device)
+	}
+	return state
+}
+
+// Restore restores the state of all mapped Devices, the Devices have to be mapped like they were
+// when the Snapshot was taken. See Snapshotter.
+func (m *MemoryMap) Restore(state any) error {
+	s, ok := state.(memoryMapState)
+	if !ok || len(s.regions) != len(m.regions) {
+		return &SnapshotError{Target: m, State: state}
+	}
+	for i, r := range m.regions {
+		if err := restoreDevice(r.device, s.regions[i]); err != nil {
+			return err
+		}
+	}
+	m.dataBus = s.dataBus
+	return nil
+}
+
+// Snapshot returns a copy of the RAM, see Snapshotter.
+func (r RAM) Snapshot() any {
+	return slices.Clone(r)
+}
+
+// Restore copies the Snapshot into the RAM, see Snapshotter.
+func (r RAM) Restore(state any) error {
+	s, ok := state.(RAM)
+	if !ok || len(s) != len(r) {
+		return &SnapshotError{Target: r, State: state}
+	}
+	copy(r, s)
+	return nil
+}
+
+// Snapshot returns a copy of the memory, see Snapshotter.
+func (mem *Memory16K) Snapshot() any {
+	return slices.Clone(mem.Data)
+}
+
+// Restore copies the Snapshot into the memory, see Snapshotter.
+func (mem *Memory16K) Restore(state any) error {
+	s, ok := state.([]Word)
+	if !ok || len(s) != len(mem.Data) {
+		return &SnapshotError{Target: mem, State: state}
+	}
+	copy(mem.Data, s)
+	return nil
+}
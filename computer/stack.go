@@ -0,0 +1,127 @@
+package computer
+
+// stackPage is the page the stack lives in. The StackPointer holds the low byte of the next free location.
+const stackPage Address = 0x0100
+
+// push writes a Word to the next free stack location and decrements the StackPointer.
+// The StackPointer wraps around inside the stack page, $0100 - $01FF.
+func (cpu *SixFiveOTwo) push(mem Memory, value Word) {
+	cpu.StoreWord(mem, stackPage|Address(cpu.StackPointer), value)
+	cpu.StackPointer--
+}
+
+// pull increments the StackPointer and reads the Word it points to.
+// The StackPointer wraps around inside the stack page, $0100 - $01FF.
+func (cpu *SixFiveOTwo) pull(mem Memory) Word {
+	cpu.StackPointer++
+	return cpu.FetchWord(mem, stackPage|Address(cpu.StackPointer))
+}
+
+// pushAddress pushes the high byte followed by the low byte of the address.
+func (cpu *SixFiveOTwo) pushAddress(mem Memory, address Address) {
+	cpu.push(mem, Word(address>>8))
+	cpu.push(mem, Word(address))
+}
+
+// stackRead reads the current stack location without pulling it, see dummyRead.
+func (cpu *SixFiveOTwo) stackRead(mem Memory) {
+	cpu.dummyRead(mem, stackPage|Address(cpu.StackPointer))
+}
+
+// pullAddress pulls the low byte followed by the high byte of an address.
+func (cpu *SixFiveOTwo) pullAddress(mem Memory) Address {
+	lsb := cpu.pull(mem)
+	msb := cpu.pull(mem)
+	return Address(msb)<<8 | Address(lsb)
+}
+
+// pushStatus pushes the ProcessorStatus. The B and the unused bit only exist on the stack,
+// the breakFlag decides whether B is set in the pushed copy.
+func (cpu *SixFiveOTwo) pushStatus(mem Memory, breakFlag bool) {
+	status := cpu.Status.Status | bit5
+	if breakFlag {
+		status |= bit4
+	}
+	cpu.push(mem, status)
+}
+
+// pullStatus pulls the ProcessorStatus. The B and the unused bit of the pulled value are ignored.
+func (cpu *SixFiveOTwo) pullStatus(mem Memory) {
+	status := cpu.pull(mem)
+	cpu.Status.Status = status&^(bit4|bit5) | cpu.Status.Status&(bit4|bit5)
+}
+
+// pha PHA - Push Accumulator
+//
+// Pushes a copy of the accumulator on to the stack.
+func (cpu *SixFiveOTwo) pha(mem Memory, _ operand) {
+	cpu.push(mem, cpu.Accumulator)
+}
+
+// php PHP - Push Processor Status
+//
+// Pushes a copy of the status flags on to the stack. The copy has the B and the unused bit set.
+func (cpu *SixFiveOTwo) php(mem Memory, _ operand) {
+	cpu.pushStatus(mem, true)
+}
+
+// pla PLA - Pull Accumulator
+//
+// Pulls an 8 bit value from the stack and into the accumulator. The zero and negative flags are set as appropriate.
+func (cpu *SixFiveOTwo) pla(mem Memory, _ operand) {
+	// The 6502 needs one cycle to increment the StackPointer before it can read
+	cpu.stackRead(mem)
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.pull(mem))
+}
+
+// plp PLP - Pull Processor Status
+//
+// Pulls an 8 bit value from the stack and into the processor flags.
+func (cpu *SixFiveOTwo) plp(mem Memory, _ operand) {
+	cpu.stackRead(mem)
+	cpu.pullStatus(mem)
+}
+
+// tsx TSX - Transfer Stack Pointer to X
+//
+// Copies the current contents of the stack register into the X register and sets the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) tsx(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterX, cpu.StackPointer)
+}
+
+// txs TXS - Transfer X to Stack Pointer
+//
+// Copies the current contents of the X register into the stack register. No flags are affected.
+func (cpu *SixFiveOTwo) txs(_ Memory, _ operand) {
+	cpu.StackPointer = cpu.RegisterX
+}
+
+// phx PHX - Push X Register
+//
+// Pushes a copy of the X register on to the stack (65C02 only).
+func (cpu *SixFiveOTwo) phx(mem Memory, _ operand) {
+	cpu.push(mem, cpu.RegisterX)
+}
+
+// phy PHY - Push Y Register
+//
+// Pushes a copy of the Y register on to the stack (65C02 only).
+func (cpu *SixFiveOTwo) phy(mem Memory, _ operand) {
+	cpu.push(mem, cpu.RegisterY)
+}
+
+// plx PLX - Pull X Register
+//
+// Pulls an 8 bit value from the stack and into the X register (65C02 only). The zero and negative flags are set as appropriate.
+func (cpu *SixFiveOTwo) plx(mem Memory, _ operand) {
+	cpu.stackRead(mem)
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterX, cpu.pull(mem))
+}
+
+// ply PLY - Pull Y Register
+//
+// Pulls an 8 bit value from the stack and into the Y register (65C02 only). The zero and negative flags are set as appropriate.
+func (cpu *SixFiveOTwo) ply(mem Memory, _ operand) {
+	cpu.stackRead(mem)
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterY, cpu.pull(mem))
+}
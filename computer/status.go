@@ -17,6 +17,18 @@ const (
 	NegativeFlagPosition
 )
 
+// Bit masks for processor status flags
+const (
+	bit7 Word = 0x80
+	bit6 Word = 0x40
+	bit5 Word = 0x20
+	bit4 Word = 0x10
+	bit3 Word = 0x8
+	bit2 Word = 0x4
+	bit1 Word = 0x2
+	bit0 Word = 0x1
+)
+
 // ProcessorStatus represents the status register of the 6502 CPU
 // As instructions are executed, a set of processor flags are set or clear to record the results of the operation.
 // These flags and some additional control flags are held in a special status register. Each flag has a single bit within the register.
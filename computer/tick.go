@@ -0,0 +1,81 @@
+package computer
+
+import "iter"
+
+// ticker runs the instructions of the CPU as a coroutine for Tick.
+type ticker struct {
+	next func() (error, bool)
+	stop func()
+	// start is the Cycle the latest Tick started in
+	start uint
+}
+
+// Tick runs the CPU for a single cycle. Every cycle is exactly one access to the bus, including the
+// dummy reads and writes of the 6502, so peripherals can be clocked between two cycles of an instruction.
+//
+// The instruction in flight is suspended before each access and resumed by the next Tick. The Tick that
+// performs the last access of an instruction also completes it, the registers are up to date afterwards.
+// Interrupts are polled and the Memory is switched at the start of an instruction, so an instruction runs
+// on the Memory of the Tick it started in. An error of Step (an illegal opcode, a JAM) is returned by the
+// Tick that completes the instruction. A halted CPU returns its *JamError on every Tick without using a
+// cycle until Reset is called.
+//
+// Step, Reset and PowerOn finish an instruction that is in flight first, so Tick and Step can be mixed.
+// Tick runs the instructions in a coroutine, call Stop before discarding a CPU that was ticked or the
+// coroutine is never released.
+func (cpu *SixFiveOTwo) Tick(mem Memory) error {
+	cpu.tickMemory = mem
+	return cpu.Bus.tick(func() error {
+		_, err := cpu.step(cpu.tickMemory)
+		return err
+	})
+}
+
+// tick resumes the coroutine that runs one instruction after the other with step until the next access to
+// the bus, it starts the coroutine first if it is not running.
+func (b *Bus) tick(step func() error) error {
+	if b.ticker == nil {
+		next, stop := iter.Pull(b.ticks(step))
+		b.ticker = &ticker{next: next, stop: stop}
+	}
+	b.ticker.start = b.Cycle
+	err, _ := b.ticker.next()
+	return err
+}
+
+// ticks returns the coroutine of tick, it yields after every instruction with its error.
+func (b *Bus) ticks(step func() error) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		b.yield = yield
+		defer func() { b.yield = nil }()
+		for {
+			err := step()
+			if b.yield == nil || !yield(err) {
+				return
+			}
+		}
+	}
+}
+
+// waitForTick is called before every access to the bus. While Tick runs the CPU it suspends the
+// instruction until the next Tick, unless the current Tick did not access the bus yet.
+func (b *Bus) waitForTick() {
+	if b.yield == nil || b.Cycle == b.ticker.start {
+		return
+	}
+	if !b.yield(nil) {
+		// Tick was stopped, the rest of the instruction runs without suspending
+		b.yield = nil
+	}
+}
+
+// Stop runs the instruction Tick left in the middle to its end and releases the coroutine of Tick.
+// The next Tick starts a new one. Stop has to be called before a CPU that was ticked is discarded,
+// it does nothing if Tick was not called since the last Stop, Step, Reset or PowerOn.
+func (b *Bus) Stop() {
+	if b.ticker == nil {
+		return
+	}
+	b.ticker.stop()
+	b.ticker = nil
+}
@@ -0,0 +1,29 @@
+package computer
+
+// tax TAX - Transfer Accumulator to X
+//
+// Copies the current contents of the accumulator into the X register and sets the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) tax(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterX, cpu.Accumulator)
+}
+
+// tay TAY - Transfer Accumulator to Y
+//
+// Copies the current contents of the accumulator into the Y register and sets the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) tay(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.RegisterY, cpu.Accumulator)
+}
+
+// txa TXA - Transfer X to Accumulator
+//
+// Copies the current contents of the X register into the accumulator and sets the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) txa(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.RegisterX)
+}
+
+// tya TYA - Transfer Y to Accumulator
+//
+// Copies the current contents of the Y register into the accumulator and sets the zero and negative flags as appropriate.
+func (cpu *SixFiveOTwo) tya(_ Memory, _ operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.RegisterY)
+}
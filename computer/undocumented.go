@@ -0,0 +1,247 @@
+package computer
+
+// unstableMagic is ORed into the accumulator by the unstable ANE and LXA opcodes.
+// The real value depends on the chip and even its temperature, $EE is the one most NMOS 6502s show.
+const unstableMagic Word = 0xEE
+
+// LookupUndocumentedOpcode returns the undocumented opcode table entry for the given instruction.
+// The entry is not defined for official opcodes, use LookupOpcode for those.
+func LookupUndocumentedOpcode(instruction Instruction) Opcode {
+	return undocumentedOpcodes[instruction]
+}
+
+// lookupOpcode returns the opcode table entry the CPU executes for the given instruction.
+// The undocumented opcodes are only part of it if UndocumentedOpcodes is set, the CMOS models do not have them.
+func (cpu *SixFiveOTwo) lookupOpcode(instruction Instruction) Opcode {
+	opcode := cpu.Model.LookupOpcode(instruction)
+	if !opcode.Defined() && cpu.UndocumentedOpcodes && !cpu.Model.cmos() {
+		return undocumentedOpcodes[instruction]
+	}
+	return opcode
+}
+
+// slo SLO - Shift Left then OR
+//
+// Shifts the memory contents one bit left like ASL and ORs the result into the accumulator.
+func (cpu *SixFiveOTwo) slo(mem Memory, op operand) {
+	value := cpu.modifyOperand(mem, op, func(value Word) Word {
+		cpu.Status.SetCarryFlag(value&bit7 != 0)
+		return value << 1
+	})
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator|value)
+}
+
+// rla RLA - Rotate Left then AND
+//
+// Rotates the memory contents one bit left like ROL and ANDs the result into the accumulator.
+func (cpu *SixFiveOTwo) rla(mem Memory, op operand) {
+	value := cpu.modifyOperand(mem, op, func(value Word) Word {
+		carry := cpu.Status.GetCarryFlag()
+		cpu.Status.SetCarryFlag(value&bit7 != 0)
+		return value<<1 | carry
+	})
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator&value)
+}
+
+// sre SRE - Shift Right then Exclusive OR
+//
+// Shifts the memory contents one bit right like LSR and EORs the result into the accumulator.
+func (cpu *SixFiveOTwo) sre(mem Memory, op operand) {
+	value := cpu.modifyOperand(mem, op, func(value Word) Word {
+		cpu.Status.SetCarryFlag(value&bit0 != 0)
+		return value >> 1
+	})
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator^value)
+}
+
+// rra RRA - Rotate Right then Add with Carry
+//
+// Rotates the memory contents one bit right like ROR and adds the result to the accumulator like ADC,
+// the bit rotated out of memory is the carry of the addition.
+func (cpu *SixFiveOTwo) rra(mem Memory, op operand) {
+	value := cpu.modifyOperand(mem, op, func(value Word) Word {
+		carry := cpu.Status.GetCarryFlag()
+		cpu.Status.SetCarryFlag(value&bit0 != 0)
+		return value>>1 | carry<<7
+	})
+	if cpu.decimalMode() {
+		cpu.addDecimal(value)
+	} else {
+		cpu.addBinary(value)
+	}
+}
+
+// dcp DCP - Decrement then Compare
+//
+// Decrements the memory contents like DEC and compares the result with the accumulator like CMP.
+func (cpu *SixFiveOTwo) dcp(mem Memory, op operand) {
+	value := cpu.modifyOperand(mem, op, func(value Word) Word {
+		return value - 1
+	})
+	cpu.compare(cpu.Accumulator, value)
+}
+
+// isc ISC - Increment then Subtract with Carry
+//
+// Increments the memory contents like INC and subtracts the result from the accumulator like SBC.
+func (cpu *SixFiveOTwo) isc(mem Memory, op operand) {
+	value := cpu.modifyOperand(mem, op, func(value Word) Word {
+		return value + 1
+	})
+	if cpu.decimalMode() {
+		cpu.subtractDecimal(value)
+	} else {
+		cpu.addBinary(^value)
+	}
+}
+
+// sax SAX - Store Accumulator AND X
+//
+// Stores the accumulator ANDed with the X register in memory. No flags are affected.
+func (cpu *SixFiveOTwo) sax(mem Memory, op operand) {
+	cpu.writeOperand(mem, op, cpu.Accumulator&cpu.RegisterX)
+}
+
+// lax LAX - Load Accumulator and X Register
+//
+// Loads the memory contents into the accumulator and the X register, setting the zero and negative flags.
+func (cpu *SixFiveOTwo) lax(mem Memory, op operand) {
+	cpu.loadIntoRegisterFromOperand(&cpu.Accumulator, mem, op)
+	cpu.RegisterX = cpu.Accumulator
+}
+
+// las LAS - AND Stack Pointer into Accumulator, X and Stack Pointer
+//
+// ANDs the memory contents with the stack pointer and loads the result into the accumulator, the X register and the stack pointer.
+func (cpu *SixFiveOTwo) las(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op) & cpu.StackPointer
+	cpu.StackPointer = value
+	cpu.RegisterX = value
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, value)
+}
+
+// anc ANC - AND then copy Negative to Carry
+//
+// ANDs the immediate value into the accumulator like AND, the carry flag is set to the negative flag.
+func (cpu *SixFiveOTwo) anc(mem Memory, op operand) {
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, cpu.Accumulator&cpu.readOperand(mem, op))
+	cpu.Status.SetCarryFlag(cpu.Status.GetNegativeFlag() == 1)
+}
+
+// alr ALR - AND then Logical Shift Right
+//
+// ANDs the immediate value into the accumulator and shifts the result one bit right like LSR A.
+func (cpu *SixFiveOTwo) alr(mem Memory, op operand) {
+	value := cpu.Accumulator & cpu.readOperand(mem, op)
+	cpu.Status.SetCarryFlag(value&bit0 != 0)
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, value>>1)
+}
+
+// arr ARR - AND then Rotate Right
+//
+// ANDs the immediate value into the accumulator and rotates the result one bit right like ROR A.
+// The carry flag is taken from bit 6 of the result and the overflow flag from bit 6 XOR bit 5.
+// In decimal mode the NMOS 6502 additionally runs the result through its BCD fix-up logic.
+func (cpu *SixFiveOTwo) arr(mem Memory, op operand) {
+	value := cpu.Accumulator & cpu.readOperand(mem, op)
+	result := value>>1 | cpu.Status.GetCarryFlag()<<7
+
+	if !cpu.decimalMode() {
+		cpu.loadIntoRegisterImmediate(&cpu.Accumulator, result)
+		cpu.Status.SetCarryFlag(result&bit6 != 0)
+		cpu.Status.SetOverflowFlag((result>>6^result>>5)&bit0 != 0)
+		return
+	}
+
+	// N is the incoming carry, Z and V are taken from the result before the fix-up
+	cpu.Status.SetNegativeFlag(cpu.Status.GetCarryFlag() == 1)
+	cpu.Status.SetZeroFlag(result == 0)
+	cpu.Status.SetOverflowFlag((value^result)&bit6 != 0)
+	if value&0x0F+value&0x01 > 0x05 {
+		result = result&0xF0 | (result+0x06)&0x0F
+	}
+	highFixUp := uint16(value&0xF0)+uint16(value&0x10) > 0x50
+	if highFixUp {
+		result = result&0x0F | (result+0x60)&0xF0
+	}
+	cpu.Status.SetCarryFlag(highFixUp)
+	cpu.Accumulator = result
+}
+
+// sbx SBX - Subtract from Accumulator AND X into X
+//
+// Subtracts the immediate value from the accumulator ANDed with the X register and stores the result in X.
+// The flags are set like CMP, decimal mode and the incoming carry are ignored.
+func (cpu *SixFiveOTwo) sbx(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op)
+	register := cpu.Accumulator & cpu.RegisterX
+	cpu.compare(register, value)
+	cpu.RegisterX = register - value
+}
+
+// ane ANE - AND X and Immediate into Accumulator (unstable)
+//
+// Computes (A OR magic) AND X AND immediate into the accumulator, see unstableMagic.
+func (cpu *SixFiveOTwo) ane(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op)
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, (cpu.Accumulator|unstableMagic)&cpu.RegisterX&value)
+}
+
+// lxa LXA - AND Immediate into Accumulator and X (unstable)
+//
+// Computes (A OR magic) AND immediate into the accumulator and the X register, see unstableMagic.
+func (cpu *SixFiveOTwo) lxa(mem Memory, op operand) {
+	value := cpu.readOperand(mem, op)
+	cpu.loadIntoRegisterImmediate(&cpu.Accumulator, (cpu.Accumulator|unstableMagic)&value)
+	cpu.RegisterX = cpu.Accumulator
+}
+
+// sha SHA - Store Accumulator AND X AND High Byte (unstable)
+//
+// Stores A AND X AND the high byte of the base address plus one.
+func (cpu *SixFiveOTwo) sha(mem Memory, op operand) {
+	cpu.storeAndHighByte(mem, op, cpu.Accumulator&cpu.RegisterX, cpu.RegisterY)
+}
+
+// shx SHX - Store X AND High Byte (unstable)
+//
+// Stores X AND the high byte of the base address plus one.
+func (cpu *SixFiveOTwo) shx(mem Memory, op operand) {
+	cpu.storeAndHighByte(mem, op, cpu.RegisterX, cpu.RegisterY)
+}
+
+// shy SHY - Store Y AND High Byte (unstable)
+//
+// Stores Y AND the high byte of the base address plus one.
+func (cpu *SixFiveOTwo) shy(mem Memory, op operand) {
+	cpu.storeAndHighByte(mem, op, cpu.RegisterY, cpu.RegisterX)
+}
+
+// tas TAS - Transfer Accumulator AND X to Stack Pointer and store it AND High Byte (unstable)
+//
+// Sets the stack pointer to A AND X and stores it ANDed with the high byte of the base address plus one.
+func (cpu *SixFiveOTwo) tas(mem Memory, op operand) {
+	cpu.StackPointer = cpu.Accumulator & cpu.RegisterX
+	cpu.storeAndHighByte(mem, op, cpu.StackPointer, cpu.RegisterY)
+}
+
+// storeAndHighByte implements the stores of the SH* family. The value is ANDed with the high byte of the
+// unindexed base address plus one. If the index crossed a page the stored value also replaces the high
+// byte of the address, because the CPU puts it on the bus while it is still correcting the address.
+func (cpu *SixFiveOTwo) storeAndHighByte(mem Memory, op operand, value Word, index Word) {
+	base := op.address - Address(index)
+	value &= Word(base>>8) + 1
+	if op.pageCrossed {
+		op.address = Address(value)<<8 | op.address&0x00FF
+	}
+	cpu.writeOperand(mem, op, value)
+}
+
+// jam JAM - Halt the CPU
+//
+// The CPU stops fetching instructions and ignores interrupts, only a reset brings it back.
+// Step fills in the opcode of the JamError.
+func (cpu *SixFiveOTwo) jam(_ Memory, _ operand) {
+	cpu.ProgramCounter--
+	cpu.jammed = &JamError{ProgramCounter: cpu.ProgramCounter}
+}
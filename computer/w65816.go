@@ -0,0 +1,469 @@
+package computer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flags of the 65816 native mode. They take the place of the B and the unused bit of the 6502,
+// in emulation mode both are forced to one.
+const (
+	// IndexRegisterSelectFlagPosition the X flag, the index registers are 8 bit while it is set
+	IndexRegisterSelectFlagPosition = BreakCommandFlagPosition
+	// MemorySelectFlagPosition the M flag, the accumulator and memory accesses are 8 bit while it is set
+	MemorySelectFlagPosition = UNUSEDPosition
+)
+
+// Interrupt vectors of the 65816 in native mode. In emulation mode the 6502 vectors are used, COP has its own EmulationCOPVector.
+const (
+	// NativeCOPVector holds the address of the COP handler
+	NativeCOPVector Address = 0xFFE4
+	// NativeBRKVector holds the address of the BRK handler, in native mode BRK does not share the IRQ vector
+	NativeBRKVector Address = 0xFFE6
+	// NativeNMIVector holds the address of the non-maskable interrupt handler
+	NativeNMIVector Address = 0xFFEA
+	// NativeIRQVector holds the address of the interrupt handler
+	NativeIRQVector Address = 0xFFEE
+	// EmulationCOPVector holds the address of the COP handler in emulation mode
+	EmulationCOPVector Address = 0xFFF4
+)
+
+// vectors are the native and the emulation mode vector of an interrupt
+type vectors struct {
+	native, emulation Address
+}
+
+var (
+	copVectors = vectors{NativeCOPVector, EmulationCOPVector}
+	brkVectors = vectors{NativeBRKVector, IRQVector}
+	nmiVectors = vectors{NativeNMIVector, NMIVector}
+	irqVectors = vectors{NativeIRQVector, IRQVector}
+)
+
+// SixFiveEightSixteen represents the WDC 65816, the 16 bit successor of the 65C02 used in the SNES and the Apple IIgs.
+//
+// The 65816 extends the 65C02 core of SixFiveOTwo. After a reset it runs in emulation mode, where the
+// instructions it shares with the 65C02 run on the core with 8 bit registers and the stack in page 1. They still
+// fetch from the ProgramBank, address the DataBank and the DirectPage, only a page aligned DirectPage wraps
+// around inside its page like the zero page of the 6502. XCE switches to native mode, which adds the M and X flags
+// that select 8 or 16 bit registers (see REP and SEP).
+//
+// Every access goes through the Bus of the core, so Cycle, Watch, Tick and Stop work like on the 6502.
+// The watches see the 16 bit address inside the bank of an access.
+type SixFiveEightSixteen struct {
+	// SixFiveOTwo is the core, it holds the ProgramCounter, the Status, the Bus and the interrupt lines.
+	// Its 8 bit registers are copies of the registers below while it runs an instruction in emulation mode,
+	// its own methods that take a Memory run the core without the 65816.
+	SixFiveOTwo
+
+	// ProgramBank (K) is the bank of the ProgramCounter. It is only changed by long jumps, calls, returns and interrupts.
+	// The ProgramCounter wraps around inside the bank.
+	ProgramBank Word
+	// DataBank (B) is the bank of the absolute and indirect addressing modes.
+	DataBank Word
+	// DirectPage (D) is the base of the zero page addressing modes, the 65816 calls them direct page modes.
+	// The direct page is located in bank 0 and does not have to be page aligned, that costs an extra cycle though.
+	DirectPage uint16
+	// StackPointer is 16 bit, the stack is located in bank 0. In emulation mode the high byte is fixed to $01.
+	StackPointer uint16
+
+	// Accumulator holds A in the low byte and B in the high byte. While the M flag is set only A is used
+	// and B keeps its value, XBA swaps them. TCD, TCS, TDC and TSC always use both bytes.
+	Accumulator uint16
+	// RegisterX and RegisterY are 16 bit, while the X flag is set their high byte is zero.
+	RegisterX, RegisterY uint16
+
+	// Emulation is the E flag, XCE exchanges it with the carry flag
+	Emulation bool
+
+	// bankPins connects the Bus to the bank of an access, bank0 is the Memory of the core, see memoryBank
+	bankPins, bank0 memoryBank
+	// banks are the bank registers the core applies in emulation mode, see emulate
+	banks emulationBanks
+
+	// tickMemory24 is the Memory24 of the latest Tick, the next instruction runs on it
+	tickMemory24 Memory24
+}
+
+func NewSixFiveEightSixteen(logger CpuLogger) *SixFiveEightSixteen {
+	return &SixFiveEightSixteen{
+		SixFiveOTwo: SixFiveOTwo{Bus: Bus{logger: logger}, Model: w65816Emulation},
+	}
+}
+
+// LookupW65816Opcode returns the opcode table entry of the 65816 for the given instruction.
+// Bytes is the length with 8 bit registers, an Immediate operand of a 16 bit register is one byte longer.
+func LookupW65816Opcode(instruction Instruction) Opcode {
+	return w65816Opcodes[instruction]
+}
+
+// String returns a string representation of the CPU state
+func (cpu SixFiveEightSixteen) String() string {
+	sb := strings.Builder{}
+	_, _ = fmt.Fprintln(&sb, "────────────────")
+	_, _ = fmt.Fprintf(&sb, " C   : %04d\n", cpu.Cycle)
+	_, _ = fmt.Fprintf(&sb, "PC   : %s\n", NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+	_, _ = fmt.Fprintf(&sb, "SP   : %#04x\n", cpu.StackPointer)
+	_, _ = fmt.Fprintf(&sb, "ACC  : %#04x\n", cpu.Accumulator)
+	_, _ = fmt.Fprintln(&sb, "────────────────")
+	_, _ = fmt.Fprintf(&sb, "REG X: %#04x\n", cpu.RegisterX)
+	_, _ = fmt.Fprintf(&sb, "REG Y: %#04x\n", cpu.RegisterY)
+	_, _ = fmt.Fprintf(&sb, "DP   : %#04x\n", cpu.DirectPage)
+	_, _ = fmt.Fprintf(&sb, "DB   : %s\n", cpu.DataBank)
+	_, _ = fmt.Fprintf(&sb, "E    : %t\n", cpu.Emulation)
+	_, _ = fmt.Fprintln(&sb, "────────────────")
+	_, _ = fmt.Fprintf(&sb, "%v\n", cpu.Status)
+	return sb.String()
+}
+
+// PowerOn brings the CPU into the state after switching on the machine and runs the Reset sequence.
+//
+// Registers, flags, the cycle counter and the interrupt lines are cleared. The content of
+// memory is not touched, so the program and the reset vector have to be in place already.
+func (cpu *SixFiveEightSixteen) PowerOn(mem Memory24) {
+	cpu.Stop()
+	cpu.Cycle = 0
+	cpu.StackPointer = 0
+	cpu.Accumulator = 0
+	cpu.RegisterX = 0
+	cpu.RegisterY = 0
+	cpu.Status.Reset()
+	cpu.irq = false
+	cpu.Reset(mem)
+}
+
+// Reset runs the reset sequence of the core in bank 0, see SixFiveOTwo.Reset.
+//
+// The CPU switches to emulation mode: M and X are set, the high bytes of the index registers are cleared
+// and the stack is moved back into page 1. The DirectPage, the DataBank and the ProgramBank are cleared.
+func (cpu *SixFiveEightSixteen) Reset(mem Memory24) {
+	cpu.Stop()
+	cpu.Emulation = true
+	cpu.DirectPage = 0
+	cpu.DataBank = 0
+	cpu.ProgramBank = 0
+	cpu.emulate(mem, func(mem Memory) {
+		cpu.SixFiveOTwo.Reset(mem)
+	})
+}
+
+// emulationBanks are the bank registers of the 65816 the core applies to its accesses in emulation mode. The
+// ProgramCounter addresses the ProgramBank, the absolute and indirect modes the DataBank and the zero page
+// modes the DirectPage. The stack, the vectors and the pointer of JMP indirect are located in bank 0,
+// the Memory of the core.
+type emulationBanks struct {
+	mem           Memory24
+	program, data Word
+	direct        Address
+
+	// programPins and operandPins are the banks of the latest accesses, see programMemory and operandMemory
+	programPins, operandPins memoryBank
+}
+
+// emulate runs f on the core with bank 0 of the Memory24 as its Memory and the bank registers as its banks.
+//
+// The 8 bit registers of the core are loaded from the low bytes of the registers and copied back afterwards,
+// B keeps its value. The core sees the status without M and X, like the 6502 it only has B on the stack.
+func (cpu *SixFiveEightSixteen) emulate(mem Memory24, f func(mem Memory)) {
+	core := &cpu.SixFiveOTwo
+	core.Accumulator = Word(cpu.Accumulator)
+	core.RegisterX = Word(cpu.RegisterX)
+	core.RegisterY = Word(cpu.RegisterY)
+	core.StackPointer = Word(cpu.StackPointer)
+	core.Status.Status &^= bit4 | bit5
+	cpu.banks = emulationBanks{mem: mem, program: cpu.ProgramBank, data: cpu.DataBank, direct: Address(cpu.DirectPage)}
+	core.banks = &cpu.banks
+	cpu.bank0 = memoryBank{mem: mem}
+	f(&cpu.bank0)
+	core.banks = nil
+	cpu.Accumulator = cpu.Accumulator&0xFF00 | uint16(core.Accumulator)
+	cpu.RegisterX = uint16(core.RegisterX)
+	cpu.RegisterY = uint16(core.RegisterY)
+	cpu.StackPointer = uint16(stackPage) | uint16(core.StackPointer)
+	cpu.setStatus(cpu.Status.Status)
+}
+
+// read reads a Word from memory in one cycle, see Bus.
+func (cpu *SixFiveEightSixteen) read(mem Memory24, address LongAddress) Word {
+	return cpu.busRead(mem, address, WatchRead)
+}
+
+// busRead implements read, the kind tells the watches an opcode fetch from other reads.
+func (cpu *SixFiveEightSixteen) busRead(mem Memory24, address LongAddress, kind WatchKind) Word {
+	return cpu.Bus.read(cpu.pinsOf(mem, address), address.Address(), kind)
+}
+
+// write writes a Word to memory in one cycle, see Bus.
+func (cpu *SixFiveEightSixteen) write(mem Memory24, address LongAddress, value Word) {
+	cpu.Bus.write(cpu.pinsOf(mem, address), address.Address(), value)
+}
+
+// pinsOf returns the bank of the address as the Bus sees it, see memoryBank.
+func (cpu *SixFiveEightSixteen) pinsOf(mem Memory24, address LongAddress) busMemory {
+	cpu.bankPins = memoryBank{mem: mem, bank: address.Bank()}
+	return &cpu.bankPins
+}
+
+// idle spends an internal cycle. The 65816 does not access memory in it, but the Bus counts every cycle as
+// an access, so it reads the ProgramCounter like the dummy reads of the 6502 (see SixFiveOTwo.dummyRead).
+func (cpu *SixFiveEightSixteen) idle(mem Memory24) {
+	cpu.read(mem, NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+}
+
+// readWord reads a little endian 16 bit value. The two locations are passed separately because
+// the direct page and stack modes wrap around inside bank 0.
+func (cpu *SixFiveEightSixteen) readWord(mem Memory24, lsbAddress, msbAddress LongAddress) uint16 {
+	lsb := cpu.read(mem, lsbAddress)
+	msb := cpu.read(mem, msbAddress)
+	return uint16(msb)<<8 | uint16(lsb)
+}
+
+// fetch reads the Word at the ProgramCounter in the ProgramBank and increments the ProgramCounter.
+func (cpu *SixFiveEightSixteen) fetch(mem Memory24) Word {
+	data := cpu.read(mem, NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+	cpu.ProgramCounter++
+	return data
+}
+
+// fetchAddress fetches a little endian 16 bit address.
+func (cpu *SixFiveEightSixteen) fetchAddress(mem Memory24) Address {
+	lsb := cpu.fetch(mem)
+	msb := cpu.fetch(mem)
+	return Address(msb)<<8 | Address(lsb)
+}
+
+// fetchLongAddress fetches a little endian 24 bit address.
+func (cpu *SixFiveEightSixteen) fetchLongAddress(mem Memory24) LongAddress {
+	address := cpu.fetchAddress(mem)
+	return NewLongAddress(cpu.fetch(mem), address)
+}
+
+// wideAccumulator reports whether the accumulator and memory accesses are 16 bit (M flag clear).
+func (cpu *SixFiveEightSixteen) wideAccumulator() bool {
+	return cpu.Status.GetFlag(MemorySelectFlagPosition) == 0
+}
+
+// wideIndex reports whether the index registers are 16 bit (X flag clear).
+func (cpu *SixFiveEightSixteen) wideIndex() bool {
+	return cpu.Status.GetFlag(IndexRegisterSelectFlagPosition) == 0
+}
+
+// widthMasks returns the mask of all bits and the sign bit of an 8 or 16 bit value.
+func widthMasks(wide bool) (mask, sign uint16) {
+	if wide {
+		return 0xFFFF, 0x8000
+	}
+	return 0x00FF, 0x0080
+}
+
+// setStatus sets the ProcessorStatus. In emulation mode M and X can not be cleared,
+// 8 bit index registers lose their high byte.
+func (cpu *SixFiveEightSixteen) setStatus(status Word) {
+	if cpu.Emulation {
+		status |= bit4 | bit5
+	}
+	cpu.Status.Status = status
+	if !cpu.wideIndex() {
+		cpu.RegisterX &= 0x00FF
+		cpu.RegisterY &= 0x00FF
+	}
+	if cpu.Emulation {
+		cpu.StackPointer = 0x0100 | cpu.StackPointer&0x00FF
+	}
+}
+
+// evaluateAndSetStatusFlags updates the Zero (Z) and Negative (N) flags for an 8 or 16 bit value.
+func (cpu *SixFiveEightSixteen) evaluateAndSetStatusFlags(data uint16, wide bool) {
+	mask, sign := widthMasks(wide)
+	cpu.Status.SetZeroFlag(data&mask == 0)
+	cpu.Status.SetNegativeFlag(data&sign != 0)
+}
+
+// accumulator returns A, or both bytes if the accumulator is 16 bit.
+func (cpu *SixFiveEightSixteen) accumulator() uint16 {
+	mask, _ := widthMasks(cpu.wideAccumulator())
+	return cpu.Accumulator & mask
+}
+
+// loadAccumulator stores the value in A, or in both bytes if the accumulator is 16 bit,
+// and sets the zero and negative flags.
+func (cpu *SixFiveEightSixteen) loadAccumulator(data uint16) {
+	wide := cpu.wideAccumulator()
+	mask, _ := widthMasks(wide)
+	cpu.Accumulator = cpu.Accumulator&^mask | data&mask
+	cpu.evaluateAndSetStatusFlags(data, wide)
+}
+
+// loadIndex stores the value in an index register, only the low byte if the index registers are 8 bit,
+// and sets the zero and negative flags.
+func (cpu *SixFiveEightSixteen) loadIndex(reg *uint16, data uint16) {
+	wide := cpu.wideIndex()
+	mask, _ := widthMasks(wide)
+	*reg = data & mask
+	cpu.evaluateAndSetStatusFlags(data, wide)
+}
+
+// Execute runs whole instructions until at least cyclesToRun cycles have passed,
+// with 0 it runs until an error occurs. If verbose is set the CPU state is logged after every instruction.
+func (cpu *SixFiveEightSixteen) Execute(cyclesToRun uint, mem Memory24, verbose bool) error {
+	executionEnd := cpu.Cycle + cyclesToRun
+	for cyclesToRun == 0 || cpu.Cycle < executionEnd {
+		if _, err := cpu.Step(mem); err != nil {
+			return err
+		}
+		if verbose {
+			cpu.logger.LogS("%s", cpu)
+		}
+	}
+	return nil
+}
+
+// Step runs a single instruction and reports the number of cycles it used, see SixFiveOTwo.Step.
+//
+// All 256 opcodes of the 65816 are defined, see LookupW65816Opcode. In emulation mode the instructions
+// the 65816 shares with the 65C02 run on the core. VerifyCycles checks the instructions that start in
+// emulation mode, the cycles of native mode depend on the M and X flags and are not checked.
+func (cpu *SixFiveEightSixteen) Step(mem Memory24) (uint, error) {
+	cpu.Stop()
+	return cpu.step(mem)
+}
+
+// step implements Step, it is also run by Tick.
+func (cpu *SixFiveEightSixteen) step(mem Memory24) (uint, error) {
+	if cpu.jammed != nil {
+		return 0, cpu.jammed
+	}
+	start := cpu.Cycle
+	cpu.instructionAddress = cpu.ProgramCounter
+	if cpu.waiting {
+		if !cpu.nmiPending && !cpu.irq {
+			cpu.idle(mem)
+			return cpu.Cycle - start, nil
+		}
+		cpu.waiting = false
+	}
+	if cpu.serviceInterrupts(mem) {
+		return cpu.Cycle - start, nil
+	}
+
+	instruction := Instruction(cpu.busRead(mem, NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter), WatchExecute))
+	cpu.ProgramCounter++
+	opcode := w65816Opcodes[instruction]
+	cpu.logger.LogE("%s %s\n", opcode.Mnemonic, opcode.Mode)
+
+	emulation := cpu.Emulation
+	var expected uint
+	if emulation && opcode.execute != nil {
+		cpu.emulate(mem, func(mem Memory) {
+			op := cpu.SixFiveOTwo.executeOpcode(mem, instruction, opcode)
+			expected = cpu.SixFiveOTwo.expectedCycles(mem, opcode, op)
+		})
+	} else {
+		op := cpu.resolve(mem, opcode.Mode)
+		w65816Handlers[instruction](cpu, mem, op)
+		expected = cpu.expectedCycles(opcode, op)
+	}
+	if cpu.jammed != nil {
+		cpu.jammed.Opcode = instruction
+		cpu.logger.LogE("%s\n", cpu.jammed)
+		return cpu.Cycle - start, cpu.jammed
+	}
+	if err := memoryFault(mem); err != nil {
+		cpu.logger.LogE("%s\n", err)
+		return cpu.Cycle - start, err
+	}
+	if !cpu.VerifyCycles || !emulation {
+		return cpu.Cycle - start, nil
+	}
+	if cpu.Cycle-start != expected {
+		err := &CycleVerificationError{ProgramCounter: cpu.instructionAddress, Opcode: instruction, Expected: expected, Actual: cpu.Cycle - start}
+		cpu.logger.LogE("%s\n", err)
+		return cpu.Cycle - start, err
+	}
+	return cpu.Cycle - start, nil
+}
+
+// expectedCycles returns the cycles the data sheet gives for an instruction the 65816 added that was executed
+// in emulation mode, see Step. Only a direct page that is not page aligned costs an extra cycle.
+func (cpu *SixFiveEightSixteen) expectedCycles(opcode Opcode, op longOperand) uint {
+	expected := uint(opcode.Cycles)
+	switch op.mode {
+	case ZeroPage, ZeroPageIndirectLong, ZeroPageIndirectLongY:
+		if cpu.DirectPage&0x00FF != 0 {
+			expected++
+		}
+	}
+	return expected
+}
+
+// Tick runs the CPU for a single cycle, see SixFiveOTwo.Tick. The internal cycles of the 65816 are
+// accesses to the Bus as well (see idle), so every Tick is one access.
+func (cpu *SixFiveEightSixteen) Tick(mem Memory24) error {
+	cpu.tickMemory24 = mem
+	return cpu.Bus.tick(func() error {
+		_, err := cpu.step(cpu.tickMemory24)
+		return err
+	})
+}
+
+// serviceInterrupts is called at every instruction boundary and runs the interrupt sequence
+// if an NMI is pending or the IRQ line is asserted while interrupts are enabled.
+// In emulation mode the core runs it. It reports whether an interrupt was taken.
+func (cpu *SixFiveEightSixteen) serviceInterrupts(mem Memory24) bool {
+	if cpu.Emulation {
+		var taken bool
+		cpu.emulate(mem, func(mem Memory) {
+			taken = cpu.SixFiveOTwo.serviceInterrupts(mem)
+		})
+		if taken {
+			cpu.ProgramBank = 0
+		}
+		return taken
+	}
+	var v vectors
+	switch {
+	case cpu.nmiPending:
+		cpu.nmiPending = false
+		v = nmiVectors
+		cpu.logger.LogE("NMI\n")
+	case cpu.irq && cpu.Status.GetInterruptDisableFlag() == 0:
+		v = irqVectors
+		cpu.logger.LogE("IRQ\n")
+	default:
+		return false
+	}
+	// The 65816 spends two cycles reading the next instruction without executing it
+	cpu.idle(mem)
+	cpu.idle(mem)
+	cpu.interrupt(mem, v, false)
+	return true
+}
+
+// interrupt pushes the return address and the ProcessorStatus and continues at the address stored in the vector.
+//
+// In emulation mode the core runs the sequence with the emulation vector, the breakFlag decides whether B is set
+// in the pushed status. In native mode the ProgramBank is pushed first and the native vector is used,
+// the B flag does not exist there. The ProgramBank is cleared, interrupts are disabled and the decimal flag is cleared.
+// Like on the 6502 a pending NMI hijacks the sequence.
+func (cpu *SixFiveEightSixteen) interrupt(mem Memory24, v vectors, breakFlag bool) {
+	if cpu.Emulation {
+		cpu.emulate(mem, func(mem Memory) {
+			cpu.SixFiveOTwo.interrupt(mem, v.emulation, breakFlag)
+		})
+		cpu.ProgramBank = 0
+		return
+	}
+	cpu.push(mem, cpu.ProgramBank)
+	cpu.pushWord(mem, uint16(cpu.ProgramCounter))
+	cpu.push(mem, cpu.Status.Status)
+	cpu.Status.SetInterruptDisableFlag(true)
+	cpu.Status.SetDecimalFlag(false)
+	cpu.ProgramBank = 0
+	if v != nmiVectors && cpu.nmiPending {
+		cpu.logger.LogE("NMI hijacked the interrupt\n")
+		cpu.nmiPending = false
+		v = nmiVectors
+	}
+	cpu.ProgramCounter = Address(cpu.readWord(mem, LongAddress(v.native), LongAddress(v.native+1)))
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
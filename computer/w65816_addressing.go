@@ -0,0 +1,216 @@
+package computer
+
+// longOperand is the result of resolving an addressing mode of the 65816.
+type longOperand struct {
+	mode AddressingMode
+	// address is the effective 24 bit address of the operand. For the branches it is the target,
+	// for BlockMove the destination bank is in bits 16 to 23. Immediate operands are fetched by the instruction,
+	// their length depends on the register they are used with.
+	address LongAddress
+	// source is the source bank of BlockMove
+	source Word
+	// bank0 is set for the direct page and stack relative modes,
+	// the high byte of a 16 bit value wraps around inside bank 0 then.
+	bank0 bool
+	// pageCrossed is set when indexing (or a branch) crossed a page boundary.
+	pageCrossed bool
+}
+
+// next returns the address of the high byte of a 16 bit operand.
+func (op longOperand) next() LongAddress {
+	if op.bank0 {
+		return LongAddress(Address(op.address) + 1)
+	}
+	return (op.address + 1) & longAddressMask
+}
+
+// resolve decodes the operand of the current instruction according to the addressing mode, see SixFiveOTwo.resolve.
+// It resolves the modes of native mode and the ones the 65816 added, emulation mode runs the others on the core.
+//
+// The absolute and indirect modes address the DataBank, indexing can cross into the next bank.
+// The direct page and stack modes address bank 0. Jumps take the bank of the target from the ProgramBank
+// unless the mode has a 24 bit address.
+func (cpu *SixFiveEightSixteen) resolve(mem Memory24, mode AddressingMode) longOperand {
+	op := longOperand{mode: mode}
+	switch mode {
+	case Implied, Accumulator:
+		// The 65816 spends a cycle on the byte after the opcode and throws it away
+		cpu.idle(mem)
+	case Immediate:
+	case ZeroPage:
+		op.address, op.bank0 = cpu.directPage(mem, cpu.fetch(mem), 0, false), true
+	case ZeroPageX:
+		op.address, op.bank0 = cpu.directPage(mem, cpu.fetch(mem), cpu.RegisterX, true), true
+	case ZeroPageY:
+		op.address, op.bank0 = cpu.directPage(mem, cpu.fetch(mem), cpu.RegisterY, true), true
+	case Absolute:
+		op.address = NewLongAddress(cpu.DataBank, cpu.fetchAddress(mem))
+	case AbsoluteX:
+		op.address, op.pageCrossed = longIndexed(NewLongAddress(cpu.DataBank, cpu.fetchAddress(mem)), cpu.RegisterX)
+	case AbsoluteY:
+		op.address, op.pageCrossed = longIndexed(NewLongAddress(cpu.DataBank, cpu.fetchAddress(mem)), cpu.RegisterY)
+	case Indirect:
+		pointer := cpu.fetchAddress(mem)
+		op.address = NewLongAddress(cpu.ProgramBank, Address(cpu.readWord(mem, LongAddress(pointer), LongAddress(pointer+1))))
+	case IndexedIndirect:
+		pointer := cpu.directPage(mem, cpu.fetch(mem), cpu.RegisterX, true)
+		op.address = NewLongAddress(cpu.DataBank, Address(cpu.readDirectWord(mem, pointer)))
+	case IndirectIndexed:
+		pointer := cpu.directPage(mem, cpu.fetch(mem), 0, false)
+		base := NewLongAddress(cpu.DataBank, Address(cpu.readDirectWord(mem, pointer)))
+		op.address, op.pageCrossed = longIndexed(base, cpu.RegisterY)
+	case ZeroPageIndirect:
+		pointer := cpu.directPage(mem, cpu.fetch(mem), 0, false)
+		op.address = NewLongAddress(cpu.DataBank, Address(cpu.readDirectWord(mem, pointer)))
+	case ZeroPageIndirectLong:
+		op.address = cpu.readDirectLong(mem, cpu.directPage(mem, cpu.fetch(mem), 0, false))
+	case ZeroPageIndirectLongY:
+		base := cpu.readDirectLong(mem, cpu.directPage(mem, cpu.fetch(mem), 0, false))
+		op.address, _ = longIndexed(base, cpu.RegisterY)
+	case AbsoluteLong:
+		op.address = cpu.fetchLongAddress(mem)
+	case AbsoluteLongX:
+		op.address, _ = longIndexed(cpu.fetchLongAddress(mem), cpu.RegisterX)
+	case AbsoluteIndexedIndirect:
+		base := cpu.fetchAddress(mem)
+		// The index is added to the pointer in an extra cycle, the pointer is located in the ProgramBank
+		cpu.idle(mem)
+		pointer := base + Address(cpu.RegisterX)
+		target := cpu.readWord(mem, NewLongAddress(cpu.ProgramBank, pointer), NewLongAddress(cpu.ProgramBank, pointer+1))
+		op.address = NewLongAddress(cpu.ProgramBank, Address(target))
+	case AbsoluteIndirectLong:
+		pointer := cpu.fetchAddress(mem)
+		op.address = cpu.readDirectLong(mem, LongAddress(pointer))
+	case StackRelative:
+		op.address, op.bank0 = cpu.stackRelative(mem), true
+	case StackRelativeIndirectY:
+		pointer := cpu.stackRelative(mem)
+		base := NewLongAddress(cpu.DataBank, Address(cpu.readDirectWord(mem, pointer)))
+		// The index is added in an extra cycle
+		cpu.idle(mem)
+		op.address, _ = longIndexed(base, cpu.RegisterY)
+	case Relative:
+		offset := cpu.fetch(mem)
+		target := cpu.ProgramCounter + Address(int8(offset))
+		op.address = NewLongAddress(cpu.ProgramBank, target)
+		op.pageCrossed = target&0xFF00 != cpu.ProgramCounter&0xFF00
+	case RelativeLong:
+		offset := cpu.fetchAddress(mem)
+		op.address = NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter+offset)
+	case BlockMove:
+		op.address = NewLongAddress(cpu.fetch(mem), 0)
+		op.source = cpu.fetch(mem)
+	}
+	cpu.logger.LogE("%s %s\n", mode, op.address)
+	return op
+}
+
+// directPage returns the bank 0 address of a direct page offset, optionally indexed.
+//
+// Adding the index costs a cycle, so does a DirectPage that is not page aligned.
+func (cpu *SixFiveEightSixteen) directPage(mem Memory24, offset Word, index uint16, indexed bool) LongAddress {
+	if cpu.DirectPage&0x00FF != 0 {
+		cpu.idle(mem)
+	}
+	if !indexed {
+		return LongAddress(cpu.DirectPage + uint16(offset))
+	}
+	cpu.idle(mem)
+	return LongAddress(cpu.DirectPage + uint16(offset) + index)
+}
+
+// stackRelative fetches the offset of the stack relative modes and returns the bank 0 address.
+func (cpu *SixFiveEightSixteen) stackRelative(mem Memory24) LongAddress {
+	offset := cpu.fetch(mem)
+	// The offset is added to the StackPointer in an extra cycle
+	cpu.idle(mem)
+	return LongAddress(cpu.StackPointer + uint16(offset))
+}
+
+// readDirectWord reads a 16 bit pointer from bank 0, the high byte wraps around inside the bank.
+func (cpu *SixFiveEightSixteen) readDirectWord(mem Memory24, pointer LongAddress) uint16 {
+	return cpu.readWord(mem, pointer, LongAddress(Address(pointer)+1))
+}
+
+// readDirectLong reads a 24 bit pointer from bank 0.
+func (cpu *SixFiveEightSixteen) readDirectLong(mem Memory24, pointer LongAddress) LongAddress {
+	address := cpu.readDirectWord(mem, pointer)
+	bank := cpu.read(mem, LongAddress(Address(pointer)+2))
+	return NewLongAddress(bank, Address(address))
+}
+
+// longIndexed adds the index to the 24 bit base address and reports whether a page boundary was crossed.
+func longIndexed(base LongAddress, index uint16) (LongAddress, bool) {
+	address := (base + LongAddress(index)) & longAddressMask
+	return address, address&0xFFFF00 != base&0xFFFF00
+}
+
+// indexPenalty spends the extra cycle of the indexed modes. Reads only pay it if a page was crossed
+// or the index registers are 16 bit, writes and read-modify-writes always pay it.
+func (cpu *SixFiveEightSixteen) indexPenalty(mem Memory24, op longOperand, kind access) {
+	switch op.mode {
+	case AbsoluteX, AbsoluteY, IndirectIndexed:
+	default:
+		return
+	}
+	if kind == readAccess && !op.pageCrossed && !cpu.wideIndex() {
+		return
+	}
+	cpu.idle(mem)
+}
+
+// readOperand returns the 8 or 16 bit value of a resolved operand. Immediate operands are fetched here.
+func (cpu *SixFiveEightSixteen) readOperand(mem Memory24, op longOperand, wide bool) uint16 {
+	switch op.mode {
+	case Accumulator:
+		return cpu.accumulator()
+	case Immediate:
+		lsb := cpu.fetch(mem)
+		if !wide {
+			return uint16(lsb)
+		}
+		return uint16(cpu.fetch(mem))<<8 | uint16(lsb)
+	}
+	cpu.indexPenalty(mem, op, readAccess)
+	lsb := cpu.read(mem, op.address)
+	if !wide {
+		return uint16(lsb)
+	}
+	return uint16(cpu.read(mem, op.next()))<<8 | uint16(lsb)
+}
+
+// writeOperand stores the 8 or 16 bit value at the address of a resolved operand.
+func (cpu *SixFiveEightSixteen) writeOperand(mem Memory24, op longOperand, value uint16, wide bool) {
+	cpu.indexPenalty(mem, op, writeAccess)
+	cpu.write(mem, op.address, Word(value))
+	if wide {
+		cpu.write(mem, op.next(), Word(value>>8))
+	}
+}
+
+// modifyOperand performs a read-modify-write on a resolved operand with the width of the accumulator
+// and returns the new value. The accumulator mode only changes A while the accumulator is 8 bit.
+//
+// The 65816 spends an internal cycle on the modification, in emulation mode the core writes the unmodified
+// value back instead. 16 bit values are written high byte first.
+func (cpu *SixFiveEightSixteen) modifyOperand(mem Memory24, op longOperand, modify func(uint16) uint16) uint16 {
+	wide := cpu.wideAccumulator()
+	mask, _ := widthMasks(wide)
+	if op.mode == Accumulator {
+		value := modify(cpu.accumulator()) & mask
+		cpu.Accumulator = cpu.Accumulator&^mask | value
+		return value
+	}
+	cpu.indexPenalty(mem, op, modifyAccess)
+	value := uint16(cpu.read(mem, op.address))
+	if wide {
+		value |= uint16(cpu.read(mem, op.next())) << 8
+	}
+	cpu.idle(mem)
+	value = modify(value) & mask
+	if wide {
+		cpu.write(mem, op.next(), Word(value>>8))
+	}
+	cpu.write(mem, op.address, Word(value))
+	return value
+}
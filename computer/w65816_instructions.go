@@ -0,0 +1,781 @@
+package computer
+
+// The instructions of the 65816 in native mode and the ones it added to the 65C02. They follow the 6502
+// instructions of the same name, the accumulator and memory are 16 bit while the M flag is clear and the
+// index registers while the X flag is clear. In emulation mode the core runs the instructions the 65816
+// shares with the 65C02, see SixFiveEightSixteen.
+
+// Load and store
+
+// lda LDA - Load Accumulator
+func (cpu *SixFiveEightSixteen) lda(mem Memory24, op longOperand) {
+	cpu.loadAccumulator(cpu.readOperand(mem, op, cpu.wideAccumulator()))
+}
+
+// ldx LDX - Load X Register
+func (cpu *SixFiveEightSixteen) ldx(mem Memory24, op longOperand) {
+	cpu.loadIndex(&cpu.RegisterX, cpu.readOperand(mem, op, cpu.wideIndex()))
+}
+
+// ldy LDY - Load Y Register
+func (cpu *SixFiveEightSixteen) ldy(mem Memory24, op longOperand) {
+	cpu.loadIndex(&cpu.RegisterY, cpu.readOperand(mem, op, cpu.wideIndex()))
+}
+
+// sta STA - Store Accumulator
+func (cpu *SixFiveEightSixteen) sta(mem Memory24, op longOperand) {
+	cpu.writeOperand(mem, op, cpu.Accumulator, cpu.wideAccumulator())
+}
+
+// stx STX - Store X Register
+func (cpu *SixFiveEightSixteen) stx(mem Memory24, op longOperand) {
+	cpu.writeOperand(mem, op, cpu.RegisterX, cpu.wideIndex())
+}
+
+// sty STY - Store Y Register
+func (cpu *SixFiveEightSixteen) sty(mem Memory24, op longOperand) {
+	cpu.writeOperand(mem, op, cpu.RegisterY, cpu.wideIndex())
+}
+
+// stz STZ - Store Zero
+func (cpu *SixFiveEightSixteen) stz(mem Memory24, op longOperand) {
+	cpu.writeOperand(mem, op, 0, cpu.wideAccumulator())
+}
+
+// Logic
+
+// and AND - Logical AND
+func (cpu *SixFiveEightSixteen) and(mem Memory24, op longOperand) {
+	cpu.loadAccumulator(cpu.accumulator() & cpu.readOperand(mem, op, cpu.wideAccumulator()))
+}
+
+// ora ORA - Logical Inclusive OR
+func (cpu *SixFiveEightSixteen) ora(mem Memory24, op longOperand) {
+	cpu.loadAccumulator(cpu.accumulator() | cpu.readOperand(mem, op, cpu.wideAccumulator()))
+}
+
+// eor EOR - Exclusive OR
+func (cpu *SixFiveEightSixteen) eor(mem Memory24, op longOperand) {
+	cpu.loadAccumulator(cpu.accumulator() ^ cpu.readOperand(mem, op, cpu.wideAccumulator()))
+}
+
+// bit BIT - Bit Test
+//
+// The zero flag is set from the accumulator ANDed with memory, the negative and overflow flags are
+// copied from the two highest bits of memory. The immediate mode only sets the zero flag.
+func (cpu *SixFiveEightSixteen) bit(mem Memory24, op longOperand) {
+	wide := cpu.wideAccumulator()
+	_, sign := widthMasks(wide)
+	value := cpu.readOperand(mem, op, wide)
+	cpu.Status.SetZeroFlag(cpu.accumulator()&value == 0)
+	if op.mode != Immediate {
+		cpu.Status.SetNegativeFlag(value&sign != 0)
+		cpu.Status.SetOverflowFlag(value&(sign>>1) != 0)
+	}
+}
+
+// tsb TSB - Test and Set Bits
+func (cpu *SixFiveEightSixteen) tsb(mem Memory24, op longOperand) {
+	cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		cpu.Status.SetZeroFlag(cpu.accumulator()&value == 0)
+		return value | cpu.accumulator()
+	})
+}
+
+// trb TRB - Test and Reset Bits
+func (cpu *SixFiveEightSixteen) trb(mem Memory24, op longOperand) {
+	cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		cpu.Status.SetZeroFlag(cpu.accumulator()&value == 0)
+		return value &^ cpu.accumulator()
+	})
+}
+
+// Arithmetic
+
+// adc ADC - Add with Carry
+//
+// In decimal mode every nibble is a BCD digit, unlike on the 6502 the flags are valid and no extra cycle is spent.
+func (cpu *SixFiveEightSixteen) adc(mem Memory24, op longOperand) {
+	cpu.add(cpu.readOperand(mem, op, cpu.wideAccumulator()))
+}
+
+// sbc SBC - Subtract with Carry
+func (cpu *SixFiveEightSixteen) sbc(mem Memory24, op longOperand) {
+	value := cpu.readOperand(mem, op, cpu.wideAccumulator())
+	if cpu.Status.GetDecimalFlag() == 1 {
+		cpu.subtractDecimal(value)
+		return
+	}
+	mask, _ := widthMasks(cpu.wideAccumulator())
+	cpu.add(^value & mask)
+}
+
+// add adds the value and the carry to the accumulator, digit by digit in decimal mode.
+// The overflow flag of a decimal addition is taken before the highest digit is adjusted.
+func (cpu *SixFiveEightSixteen) add(value uint16) {
+	wide := cpu.wideAccumulator()
+	mask, sign := widthMasks(wide)
+	a := uint32(cpu.accumulator())
+	carry := uint32(cpu.Status.GetCarryFlag())
+
+	result := a + uint32(value) + carry
+	unadjusted := result
+	if cpu.Status.GetDecimalFlag() == 1 {
+		result = 0
+		for shift := 0; mask>>shift != 0; shift += 4 {
+			digit := a>>shift&0xF + uint32(value)>>shift&0xF + carry
+			unadjusted = result | digit<<shift
+			carry = 0
+			if digit > 9 {
+				digit += 6
+				carry = 1
+			}
+			result |= (digit & 0xF) << shift
+		}
+		result |= carry << 16
+		if !wide {
+			result = result&0xFF | carry<<8
+		}
+	}
+	cpu.Status.SetCarryFlag(result > uint32(mask))
+	cpu.Status.SetOverflowFlag(^(a^uint32(value))&(a^unadjusted)&uint32(sign) != 0)
+	cpu.loadAccumulator(uint16(result))
+}
+
+// subtractDecimal subtracts the value and the borrow from the accumulator digit by digit.
+// The overflow flag is the one of the binary subtraction.
+func (cpu *SixFiveEightSixteen) subtractDecimal(value uint16) {
+	mask, sign := widthMasks(cpu.wideAccumulator())
+	a := cpu.accumulator()
+	borrow := 1 - int(cpu.Status.GetCarryFlag())
+
+	binary := a - value - uint16(borrow)
+	var result uint16
+	for shift := 0; mask>>shift != 0; shift += 4 {
+		digit := int(a>>shift&0xF) - int(value>>shift&0xF) - borrow
+		borrow = 0
+		if digit < 0 {
+			digit += 10
+			borrow = 1
+		}
+		result |= uint16(digit&0xF) << shift
+	}
+	cpu.Status.SetCarryFlag(borrow == 0)
+	cpu.Status.SetOverflowFlag((a^value)&(a^binary)&sign != 0)
+	cpu.loadAccumulator(result)
+}
+
+// compare sets the flags like subtracting the value from the register without storing the result.
+func (cpu *SixFiveEightSixteen) compare(register uint16, value uint16, wide bool) {
+	mask, _ := widthMasks(wide)
+	register &= mask
+	cpu.Status.SetCarryFlag(register >= value)
+	cpu.evaluateAndSetStatusFlags(register-value, wide)
+}
+
+// cmp CMP - Compare
+func (cpu *SixFiveEightSixteen) cmp(mem Memory24, op longOperand) {
+	wide := cpu.wideAccumulator()
+	cpu.compare(cpu.Accumulator, cpu.readOperand(mem, op, wide), wide)
+}
+
+// cpx CPX - Compare X Register
+func (cpu *SixFiveEightSixteen) cpx(mem Memory24, op longOperand) {
+	wide := cpu.wideIndex()
+	cpu.compare(cpu.RegisterX, cpu.readOperand(mem, op, wide), wide)
+}
+
+// cpy CPY - Compare Y Register
+func (cpu *SixFiveEightSixteen) cpy(mem Memory24, op longOperand) {
+	wide := cpu.wideIndex()
+	cpu.compare(cpu.RegisterY, cpu.readOperand(mem, op, wide), wide)
+}
+
+// Increment and decrement
+
+// inc INC - Increment Memory
+func (cpu *SixFiveEightSixteen) inc(mem Memory24, op longOperand) {
+	value := cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		return value + 1
+	})
+	cpu.evaluateAndSetStatusFlags(value, cpu.wideAccumulator())
+}
+
+// dec DEC - Decrement Memory
+func (cpu *SixFiveEightSixteen) dec(mem Memory24, op longOperand) {
+	value := cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		return value - 1
+	})
+	cpu.evaluateAndSetStatusFlags(value, cpu.wideAccumulator())
+}
+
+// inx INX - Increment X Register
+func (cpu *SixFiveEightSixteen) inx(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterX, cpu.RegisterX+1)
+}
+
+// iny INY - Increment Y Register
+func (cpu *SixFiveEightSixteen) iny(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterY, cpu.RegisterY+1)
+}
+
+// dex DEX - Decrement X Register
+func (cpu *SixFiveEightSixteen) dex(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterX, cpu.RegisterX-1)
+}
+
+// dey DEY - Decrement Y Register
+func (cpu *SixFiveEightSixteen) dey(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterY, cpu.RegisterY-1)
+}
+
+// Shifts and rotates
+
+// asl ASL - Arithmetic Shift Left
+func (cpu *SixFiveEightSixteen) asl(mem Memory24, op longOperand) {
+	_, sign := widthMasks(cpu.wideAccumulator())
+	value := cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		cpu.Status.SetCarryFlag(value&sign != 0)
+		return value << 1
+	})
+	cpu.evaluateAndSetStatusFlags(value, cpu.wideAccumulator())
+}
+
+// lsr LSR - Logical Shift Right
+func (cpu *SixFiveEightSixteen) lsr(mem Memory24, op longOperand) {
+	value := cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		cpu.Status.SetCarryFlag(value&1 != 0)
+		return value >> 1
+	})
+	cpu.evaluateAndSetStatusFlags(value, cpu.wideAccumulator())
+}
+
+// rol ROL - Rotate Left
+func (cpu *SixFiveEightSixteen) rol(mem Memory24, op longOperand) {
+	_, sign := widthMasks(cpu.wideAccumulator())
+	value := cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		carry := uint16(cpu.Status.GetCarryFlag())
+		cpu.Status.SetCarryFlag(value&sign != 0)
+		return value<<1 | carry
+	})
+	cpu.evaluateAndSetStatusFlags(value, cpu.wideAccumulator())
+}
+
+// ror ROR - Rotate Right
+func (cpu *SixFiveEightSixteen) ror(mem Memory24, op longOperand) {
+	_, sign := widthMasks(cpu.wideAccumulator())
+	value := cpu.modifyOperand(mem, op, func(value uint16) uint16 {
+		carry := cpu.Status.GetCarryFlag() == 1
+		cpu.Status.SetCarryFlag(value&1 != 0)
+		value >>= 1
+		if carry {
+			value |= sign
+		}
+		return value
+	})
+	cpu.evaluateAndSetStatusFlags(value, cpu.wideAccumulator())
+}
+
+// Branches
+
+// branch jumps to the target of a Relative operand if the condition holds.
+// A taken branch costs one cycle, in native mode crossing a page does not cost another one.
+func (cpu *SixFiveEightSixteen) branch(mem Memory24, op longOperand, condition bool) {
+	if !condition {
+		return
+	}
+	cpu.idle(mem)
+	cpu.ProgramCounter = op.address.Address()
+	cpu.logger.LogE("Branch taken %s\n", cpu.ProgramCounter)
+}
+
+// bcc BCC - Branch if Carry Clear
+func (cpu *SixFiveEightSixteen) bcc(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetCarryFlag() == 0)
+}
+
+// bcs BCS - Branch if Carry Set
+func (cpu *SixFiveEightSixteen) bcs(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetCarryFlag() == 1)
+}
+
+// beq BEQ - Branch if Equal
+func (cpu *SixFiveEightSixteen) beq(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetZeroFlag() == 1)
+}
+
+// bne BNE - Branch if Not Equal
+func (cpu *SixFiveEightSixteen) bne(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetZeroFlag() == 0)
+}
+
+// bmi BMI - Branch if Minus
+func (cpu *SixFiveEightSixteen) bmi(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetNegativeFlag() == 1)
+}
+
+// bpl BPL - Branch if Positive
+func (cpu *SixFiveEightSixteen) bpl(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetNegativeFlag() == 0)
+}
+
+// bvc BVC - Branch if Overflow Clear
+func (cpu *SixFiveEightSixteen) bvc(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetOverflowFlag() == 0)
+}
+
+// bvs BVS - Branch if Overflow Set
+func (cpu *SixFiveEightSixteen) bvs(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, cpu.Status.GetOverflowFlag() == 1)
+}
+
+// bra BRA - Branch Always
+func (cpu *SixFiveEightSixteen) bra(mem Memory24, op longOperand) {
+	cpu.branch(mem, op, true)
+}
+
+// brl BRL - Branch Always Long
+//
+// Branches to a 16 bit offset anywhere in the ProgramBank, it always takes 4 cycles.
+func (cpu *SixFiveEightSixteen) brl(mem Memory24, op longOperand) {
+	cpu.idle(mem)
+	cpu.ProgramCounter = op.address.Address()
+	cpu.logger.LogE("Branch taken %s\n", cpu.ProgramCounter)
+}
+
+// Flags
+
+// clc CLC - Clear Carry Flag
+func (cpu *SixFiveEightSixteen) clc(_ Memory24, _ longOperand) {
+	cpu.Status.SetCarryFlag(false)
+}
+
+// sec SEC - Set Carry Flag
+func (cpu *SixFiveEightSixteen) sec(_ Memory24, _ longOperand) {
+	cpu.Status.SetCarryFlag(true)
+}
+
+// cli CLI - Clear Interrupt Disable
+func (cpu *SixFiveEightSixteen) cli(_ Memory24, _ longOperand) {
+	cpu.Status.SetInterruptDisableFlag(false)
+}
+
+// sei SEI - Set Interrupt Disable
+func (cpu *SixFiveEightSixteen) sei(_ Memory24, _ longOperand) {
+	cpu.Status.SetInterruptDisableFlag(true)
+}
+
+// cld CLD - Clear Decimal Mode
+func (cpu *SixFiveEightSixteen) cld(_ Memory24, _ longOperand) {
+	cpu.Status.SetDecimalFlag(false)
+}
+
+// sed SED - Set Decimal Flag
+func (cpu *SixFiveEightSixteen) sed(_ Memory24, _ longOperand) {
+	cpu.Status.SetDecimalFlag(true)
+}
+
+// clv CLV - Clear Overflow Flag
+func (cpu *SixFiveEightSixteen) clv(_ Memory24, _ longOperand) {
+	cpu.Status.SetOverflowFlag(false)
+}
+
+// rep REP - Reset Processor Status Bits
+//
+// Clears every flag that is set in the immediate value. In emulation mode M and X stay set.
+func (cpu *SixFiveEightSixteen) rep(mem Memory24, op longOperand) {
+	value := Word(cpu.readOperand(mem, op, false))
+	cpu.idle(mem)
+	cpu.setStatus(cpu.Status.Status &^ value)
+}
+
+// sep SEP - Set Processor Status Bits
+//
+// Sets every flag that is set in the immediate value. Setting X clears the high bytes of the index registers.
+func (cpu *SixFiveEightSixteen) sep(mem Memory24, op longOperand) {
+	value := Word(cpu.readOperand(mem, op, false))
+	cpu.idle(mem)
+	cpu.setStatus(cpu.Status.Status | value)
+}
+
+// xce XCE - Exchange Carry and Emulation Flags
+//
+// The only way to switch between emulation and native mode: CLC XCE enters native mode, SEC XCE returns
+// to emulation mode. Native mode starts with 8 bit registers, emulation mode forces them.
+func (cpu *SixFiveEightSixteen) xce(_ Memory24, _ longOperand) {
+	carry := cpu.Status.GetCarryFlag() == 1
+	cpu.Status.SetCarryFlag(cpu.Emulation)
+	cpu.Emulation = carry
+	cpu.setStatus(cpu.Status.Status)
+	cpu.logger.LogE("Emulation %t\n", cpu.Emulation)
+}
+
+// Transfers
+
+// tax TAX - Transfer Accumulator to X
+//
+// With 16 bit index registers both bytes of the accumulator are copied, even if the accumulator is 8 bit.
+func (cpu *SixFiveEightSixteen) tax(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterX, cpu.Accumulator)
+}
+
+// tay TAY - Transfer Accumulator to Y
+func (cpu *SixFiveEightSixteen) tay(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterY, cpu.Accumulator)
+}
+
+// txa TXA - Transfer X to Accumulator
+func (cpu *SixFiveEightSixteen) txa(_ Memory24, _ longOperand) {
+	cpu.loadAccumulator(cpu.RegisterX)
+}
+
+// tya TYA - Transfer Y to Accumulator
+func (cpu *SixFiveEightSixteen) tya(_ Memory24, _ longOperand) {
+	cpu.loadAccumulator(cpu.RegisterY)
+}
+
+// txy TXY - Transfer X to Y
+func (cpu *SixFiveEightSixteen) txy(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterY, cpu.RegisterX)
+}
+
+// tyx TYX - Transfer Y to X
+func (cpu *SixFiveEightSixteen) tyx(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterX, cpu.RegisterY)
+}
+
+// tsx TSX - Transfer Stack Pointer to X
+func (cpu *SixFiveEightSixteen) tsx(_ Memory24, _ longOperand) {
+	cpu.loadIndex(&cpu.RegisterX, cpu.StackPointer)
+}
+
+// txs TXS - Transfer X to Stack Pointer
+//
+// No flags are affected.
+func (cpu *SixFiveEightSixteen) txs(_ Memory24, _ longOperand) {
+	cpu.setStackPointer(cpu.RegisterX)
+}
+
+// tcs TCS - Transfer 16 bit Accumulator to Stack Pointer
+func (cpu *SixFiveEightSixteen) tcs(_ Memory24, _ longOperand) {
+	cpu.setStackPointer(cpu.Accumulator)
+}
+
+// tsc TSC - Transfer Stack Pointer to 16 bit Accumulator
+func (cpu *SixFiveEightSixteen) tsc(_ Memory24, _ longOperand) {
+	cpu.Accumulator = cpu.StackPointer
+	cpu.evaluateAndSetStatusFlags(cpu.Accumulator, true)
+}
+
+// tcd TCD - Transfer 16 bit Accumulator to Direct Page Register
+func (cpu *SixFiveEightSixteen) tcd(_ Memory24, _ longOperand) {
+	cpu.DirectPage = cpu.Accumulator
+	cpu.evaluateAndSetStatusFlags(cpu.DirectPage, true)
+}
+
+// tdc TDC - Transfer Direct Page Register to 16 bit Accumulator
+func (cpu *SixFiveEightSixteen) tdc(_ Memory24, _ longOperand) {
+	cpu.Accumulator = cpu.DirectPage
+	cpu.evaluateAndSetStatusFlags(cpu.Accumulator, true)
+}
+
+// xba XBA - Exchange B and A Accumulator
+//
+// Swaps the two bytes of the accumulator, the zero and negative flags are set from the new A.
+func (cpu *SixFiveEightSixteen) xba(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.Accumulator = cpu.Accumulator<<8 | cpu.Accumulator>>8
+	cpu.evaluateAndSetStatusFlags(cpu.Accumulator, false)
+}
+
+// setStackPointer sets the StackPointer, in emulation mode the high byte stays $01.
+func (cpu *SixFiveEightSixteen) setStackPointer(value uint16) {
+	if cpu.Emulation {
+		value = 0x0100 | value&0x00FF
+	}
+	cpu.StackPointer = value
+}
+
+// Stack
+
+// push writes a Word to the next free stack location in bank 0 and decrements the StackPointer.
+// In emulation mode the StackPointer wraps around inside page 1.
+func (cpu *SixFiveEightSixteen) push(mem Memory24, value Word) {
+	cpu.write(mem, LongAddress(cpu.StackPointer), value)
+	cpu.setStackPointer(cpu.StackPointer - 1)
+}
+
+// pull increments the StackPointer and reads the Word it points to.
+func (cpu *SixFiveEightSixteen) pull(mem Memory24) Word {
+	cpu.setStackPointer(cpu.StackPointer + 1)
+	return cpu.read(mem, LongAddress(cpu.StackPointer))
+}
+
+// pushValue pushes an 8 or 16 bit value, the high byte first.
+func (cpu *SixFiveEightSixteen) pushValue(mem Memory24, value uint16, wide bool) {
+	if wide {
+		cpu.push(mem, Word(value>>8))
+	}
+	cpu.push(mem, Word(value))
+}
+
+// pullValue pulls an 8 or 16 bit value, the low byte first.
+func (cpu *SixFiveEightSixteen) pullValue(mem Memory24, wide bool) uint16 {
+	value := uint16(cpu.pull(mem))
+	if wide {
+		value |= uint16(cpu.pull(mem)) << 8
+	}
+	return value
+}
+
+// pushWord pushes a 16 bit value, the high byte first.
+func (cpu *SixFiveEightSixteen) pushWord(mem Memory24, value uint16) {
+	cpu.pushValue(mem, value, true)
+}
+
+// pullWord pulls a 16 bit value, the low byte first.
+func (cpu *SixFiveEightSixteen) pullWord(mem Memory24) uint16 {
+	return cpu.pullValue(mem, true)
+}
+
+// pha PHA - Push Accumulator
+func (cpu *SixFiveEightSixteen) pha(mem Memory24, _ longOperand) {
+	cpu.pushValue(mem, cpu.Accumulator, cpu.wideAccumulator())
+}
+
+// phx PHX - Push X Register
+func (cpu *SixFiveEightSixteen) phx(mem Memory24, _ longOperand) {
+	cpu.pushValue(mem, cpu.RegisterX, cpu.wideIndex())
+}
+
+// phy PHY - Push Y Register
+func (cpu *SixFiveEightSixteen) phy(mem Memory24, _ longOperand) {
+	cpu.pushValue(mem, cpu.RegisterY, cpu.wideIndex())
+}
+
+// php PHP - Push Processor Status
+//
+// In native mode the pushed copy has M and X instead of the B and the unused bit.
+func (cpu *SixFiveEightSixteen) php(mem Memory24, _ longOperand) {
+	cpu.push(mem, cpu.Status.Status)
+}
+
+// phb PHB - Push Data Bank Register
+func (cpu *SixFiveEightSixteen) phb(mem Memory24, _ longOperand) {
+	cpu.push(mem, cpu.DataBank)
+}
+
+// phk PHK - Push Program Bank Register
+func (cpu *SixFiveEightSixteen) phk(mem Memory24, _ longOperand) {
+	cpu.push(mem, cpu.ProgramBank)
+}
+
+// phd PHD - Push Direct Page Register
+func (cpu *SixFiveEightSixteen) phd(mem Memory24, _ longOperand) {
+	cpu.pushWord(mem, cpu.DirectPage)
+}
+
+// pla PLA - Pull Accumulator
+func (cpu *SixFiveEightSixteen) pla(mem Memory24, _ longOperand) {
+	// The 65816 needs one cycle to increment the StackPointer before it can read
+	cpu.idle(mem)
+	cpu.loadAccumulator(cpu.pullValue(mem, cpu.wideAccumulator()))
+}
+
+// plx PLX - Pull X Register
+func (cpu *SixFiveEightSixteen) plx(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.loadIndex(&cpu.RegisterX, cpu.pullValue(mem, cpu.wideIndex()))
+}
+
+// ply PLY - Pull Y Register
+func (cpu *SixFiveEightSixteen) ply(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.loadIndex(&cpu.RegisterY, cpu.pullValue(mem, cpu.wideIndex()))
+}
+
+// plp PLP - Pull Processor Status
+//
+// The pulled value sets M and X too.
+func (cpu *SixFiveEightSixteen) plp(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.setStatus(cpu.pull(mem))
+}
+
+// plb PLB - Pull Data Bank Register
+func (cpu *SixFiveEightSixteen) plb(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.DataBank = cpu.pull(mem)
+	cpu.evaluateAndSetStatusFlags(uint16(cpu.DataBank), false)
+}
+
+// pld PLD - Pull Direct Page Register
+func (cpu *SixFiveEightSixteen) pld(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.DirectPage = cpu.pullWord(mem)
+	cpu.evaluateAndSetStatusFlags(cpu.DirectPage, true)
+}
+
+// pea PEA - Push Effective Absolute Address
+//
+// Pushes the 16 bit operand, despite the name no memory is accessed.
+func (cpu *SixFiveEightSixteen) pea(mem Memory24, op longOperand) {
+	cpu.pushWord(mem, uint16(op.address.Address()))
+}
+
+// pei PEI - Push Effective Indirect Address
+//
+// Pushes the 16 bit value stored at the direct page location.
+func (cpu *SixFiveEightSixteen) pei(mem Memory24, op longOperand) {
+	cpu.pushWord(mem, cpu.readOperand(mem, op, true))
+}
+
+// per PER - Push Effective PC Relative Address
+//
+// Pushes the address of the 16 bit offset relative to the next instruction, used by position independent code.
+func (cpu *SixFiveEightSixteen) per(mem Memory24, op longOperand) {
+	cpu.idle(mem)
+	cpu.pushWord(mem, uint16(op.address.Address()))
+}
+
+// Control
+
+// jmp JMP - Jump and JML - Jump Long
+//
+// The modes with a 24 bit address change the ProgramBank, the other ones stay in the ProgramBank.
+func (cpu *SixFiveEightSixteen) jmp(_ Memory24, op longOperand) {
+	if op.mode == AbsoluteLong || op.mode == AbsoluteIndirectLong {
+		cpu.ProgramBank = op.address.Bank()
+	}
+	cpu.ProgramCounter = op.address.Address()
+	cpu.logger.LogE("%s\n", NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+}
+
+// jsr JSR - Jump to Subroutine
+//
+// Pushes the address (minus one) of the return point and jumps inside the ProgramBank.
+func (cpu *SixFiveEightSixteen) jsr(mem Memory24, op longOperand) {
+	if op.mode == Absolute {
+		cpu.idle(mem)
+	}
+	cpu.pushWord(mem, uint16(cpu.ProgramCounter-1))
+	cpu.ProgramCounter = op.address.Address()
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
+
+// jsl JSL - Jump to Subroutine Long
+//
+// Pushes the ProgramBank and the address (minus one) of the return point and jumps to the 24 bit address.
+func (cpu *SixFiveEightSixteen) jsl(mem Memory24, op longOperand) {
+	cpu.push(mem, cpu.ProgramBank)
+	cpu.idle(mem)
+	cpu.pushWord(mem, uint16(cpu.ProgramCounter-1))
+	cpu.ProgramBank = op.address.Bank()
+	cpu.ProgramCounter = op.address.Address()
+	cpu.logger.LogE("%s\n", op.address)
+}
+
+// rts RTS - Return from Subroutine
+func (cpu *SixFiveEightSixteen) rts(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.ProgramCounter = Address(cpu.pullWord(mem))
+	// The 65816 increments the pulled address in an extra cycle
+	cpu.ProgramCounter++
+	cpu.idle(mem)
+	cpu.logger.LogE("%s\n", cpu.ProgramCounter)
+}
+
+// rtl RTL - Return from Subroutine Long
+//
+// Pulls the address (minus one) of the return point followed by the ProgramBank.
+func (cpu *SixFiveEightSixteen) rtl(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.ProgramCounter = Address(cpu.pullWord(mem)) + 1
+	cpu.ProgramBank = cpu.pull(mem)
+	cpu.logger.LogE("%s\n", NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+}
+
+// rti RTI - Return from Interrupt
+//
+// Pulls the processor flags, the ProgramCounter and the ProgramBank.
+func (cpu *SixFiveEightSixteen) rti(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.setStatus(cpu.pull(mem))
+	cpu.ProgramCounter = Address(cpu.pullWord(mem))
+	cpu.ProgramBank = cpu.pull(mem)
+	cpu.logger.LogE("%s\n", NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+}
+
+// brk BRK - Force Interrupt
+//
+// The byte following the BRK is skipped. In native mode BRK has its own vector, see NativeBRKVector.
+func (cpu *SixFiveEightSixteen) brk(mem Memory24, _ longOperand) {
+	cpu.ProgramCounter++
+	cpu.interrupt(mem, brkVectors, true)
+}
+
+// cop COP - Co-Processor Enable
+//
+// A software interrupt like BRK with its own vector. The signature byte is skipped.
+func (cpu *SixFiveEightSixteen) cop(mem Memory24, op longOperand) {
+	cpu.readOperand(mem, op, false)
+	cpu.interrupt(mem, copVectors, true)
+}
+
+// nop NOP - No Operation
+//
+// WDM is a NOP that skips the byte following it.
+func (cpu *SixFiveEightSixteen) nop(mem Memory24, op longOperand) {
+	if op.mode == Immediate {
+		cpu.readOperand(mem, op, false)
+	}
+}
+
+// wai WAI - Wait for Interrupt
+func (cpu *SixFiveEightSixteen) wai(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.waiting = true
+	cpu.logger.LogE("Waiting for interrupt\n")
+}
+
+// stp STP - Stop the Clock
+//
+// Halts the CPU until it is reset, see JamError.
+func (cpu *SixFiveEightSixteen) stp(mem Memory24, _ longOperand) {
+	cpu.idle(mem)
+	cpu.ProgramCounter--
+	cpu.jammed = &JamError{ProgramCounter: cpu.ProgramCounter}
+}
+
+// mvn MVN - Block Move Next
+//
+// Copies the byte at X in the source bank to Y in the destination bank, increments X and Y and decrements
+// the 16 bit accumulator. The instruction repeats itself until the accumulator wraps to $FFFF,
+// so a block of C+1 bytes is moved and interrupts can be taken between the bytes.
+// The DataBank is set to the destination bank.
+func (cpu *SixFiveEightSixteen) mvn(mem Memory24, op longOperand) {
+	cpu.blockMove(mem, op, 1)
+}
+
+// mvp MVP - Block Move Previous
+//
+// Like MVN but X and Y are decremented, used when the destination overlaps the end of the source.
+func (cpu *SixFiveEightSixteen) mvp(mem Memory24, op longOperand) {
+	cpu.blockMove(mem, op, 0xFFFF)
+}
+
+// blockMove moves a single byte of MVN and MVP, step is added to the index registers.
+func (cpu *SixFiveEightSixteen) blockMove(mem Memory24, op longOperand, step uint16) {
+	cpu.DataBank = op.address.Bank()
+	value := cpu.read(mem, NewLongAddress(op.source, Address(cpu.RegisterX)))
+	cpu.write(mem, NewLongAddress(cpu.DataBank, Address(cpu.RegisterY)), value)
+	mask, _ := widthMasks(cpu.wideIndex())
+	cpu.RegisterX = (cpu.RegisterX + step) & mask
+	cpu.RegisterY = (cpu.RegisterY + step) & mask
+	cpu.Accumulator--
+	cpu.idle(mem)
+	cpu.idle(mem)
+	if cpu.Accumulator != 0xFFFF {
+		cpu.ProgramCounter -= 3
+	}
+}
@@ -0,0 +1,83 @@
+package computer
+
+import (
+	"fmt"
+	"slices"
+)
+
+// WatchKind selects the accesses to the bus a watch is called for, the kinds can be combined.
+type WatchKind uint8
+
+const (
+	// WatchRead watches reads, including the operands and dummy reads of an instruction
+	WatchRead WatchKind = 1 << iota
+	// WatchWrite watches writes, including the dummy writes of the read-modify-write instructions
+	WatchWrite
+	// WatchExecute watches the opcode fetches of instructions
+	WatchExecute
+)
+
+func (k WatchKind) String() string {
+	switch k {
+	case WatchRead:
+		return "read"
+	case WatchWrite:
+		return "write"
+	case WatchExecute:
+		return "execute"
+	}
+	return fmt.Sprintf("WatchKind(%#02x)", uint8(k))
+}
+
+// WatchEvent describes the access a watch was called for.
+type WatchEvent struct {
+	Kind    WatchKind
+	Address Address
+	// Value is the value read or written
+	Value Word
+	// Cycle is the cycle of the access, it is counted already
+	Cycle uint
+	// ProgramCounter is the address of the opcode of the instruction that accessed the bus
+	ProgramCounter Address
+}
+
+func (e WatchEvent) String() string {
+	return fmt.Sprintf("%s %s = %s in cycle %d by %s", e.Kind, e.Address, e.Value, e.Cycle, e.ProgramCounter)
+}
+
+// watch is a callback registered with Watch
+type watch struct {
+	kind       WatchKind
+	start, end Address
+	callback   func(event WatchEvent)
+}
+
+// Watch calls the callback for every access of the kind to an address from start to end inclusive,
+// e.g. for watchpoints, tracing I/O or detecting self modifying code. The callback runs in the middle
+// of the instruction, it must not Step the CPU.
+//
+// The returned function removes the watch again, a callback may remove its own or another watch.
+func (b *Bus) Watch(kind WatchKind, start, end Address, callback func(event WatchEvent)) (remove func()) {
+	w := &watch{kind: kind, start: start, end: end, callback: callback}
+	b.watches = append(b.watches, w)
+	return func() {
+		// notifyWatches may be ranging over the slice, so the watches are copied instead of deleted in place
+		b.watches = slices.DeleteFunc(slices.Clone(b.watches), func(other *watch) bool { return other == w })
+	}
+}
+
+// notifyWatches calls the watches for an access to the bus.
+func (b *Bus) notifyWatches(kind WatchKind, address Address, value Word) {
+	for _, w := range b.watches {
+		if w.kind&kind == 0 || address < w.start || address > w.end {
+			continue
+		}
+		w.callback(WatchEvent{
+			Kind:           kind,
+			Address:        address,
+			Value:          value,
+			Cycle:          b.Cycle,
+			ProgramCounter: b.instructionAddress,
+		})
+	}
+}
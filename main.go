@@ -16,15 +16,19 @@ func main() {
 
 	_ = mem.Init()
 
-	cpu.Reset(&mem)
-	_ = programs.MiniProg.CopyToMemory(cpu.ProgramCounter, &mem)
-
-	cpu.Execute(1, &mem, true)
-	cpu.AssertCycle(2)
-	cpu.Execute(1, &mem, true)
-	cpu.AssertCycle(4)
-	cpu.Execute(1, &mem, true)
-	cpu.AssertCycle(8)
+	_ = programs.MiniProg.CopyToMemory(0x0200, &mem)
+	cpu.PowerOn(&mem)
+
+	for _, cycle := range []uint{10, 12, 16} {
+		if err := cpu.Execute(1, &mem, true); err != nil {
+			fmt.Println(err)
+			break
+		}
+		if err := cpu.AssertCycle(cycle); err != nil {
+			fmt.Println(err)
+			break
+		}
+	}
 	fmt.Println(cpu)
 
 	_ = logger.Close()
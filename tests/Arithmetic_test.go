@@ -0,0 +1,151 @@
+package tests_test
+
+import (
+	"strconv"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestADC(t *testing.T) {
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	tm := ut.DefaultTestMemory(t)
+
+	data := []ut.InstructionTestData{
+		{
+			Name:                         "Carry is added",
+			AccumolatorSetup:             0x01,
+			ProcessorStatusSetup:         0b00000001,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_I), 0x01},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x03,
+			ExpectedProcessorStatusValue: 0b00000000,
+		},
+		{
+			Name:                         "Positive overflow",
+			AccumolatorSetup:             0x50,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_I), 0x50},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0xA0,
+			ExpectedProcessorStatusValue: 0b11000000,
+		},
+		{
+			Name:                         "Negative overflow with carry",
+			AccumolatorSetup:             0xD0,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_I), 0x90},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x60,
+			ExpectedProcessorStatusValue: 0b01000001,
+		},
+		{
+			Name:                         "Absolute,X crossing a page",
+			AccumolatorSetup:             0x01,
+			RegisterXSetup:               0x01,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_ABSX), 0xFF, 0x00, 0x00, 0x01},
+			ExpectToAdvancedCycles:       5,
+			ExpectAccumulatorValue:       0x02,
+			ExpectedProcessorStatusValue: 0b00000000,
+		},
+		{
+			Name:                         "Decimal",
+			AccumolatorSetup:             0x09,
+			ProcessorStatusSetup:         0b00001000,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_I), 0x01},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x10,
+			ExpectedProcessorStatusValue: 0b00001000,
+		},
+		{
+			// 58 + 46 + 1 = 105, N and V come from the intermediate result 0xA5
+			Name:                         "Decimal with carry out",
+			AccumolatorSetup:             0x58,
+			ProcessorStatusSetup:         0b00001001,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_I), 0x46},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x05,
+			ExpectedProcessorStatusValue: 0b11001001,
+		},
+		{
+			// The NMOS 6502 sets N from the intermediate result and Z from the binary sum
+			Name:                         "Decimal NMOS flags",
+			AccumolatorSetup:             0x99,
+			ProcessorStatusSetup:         0b00001000,
+			MemorySetup:                  []c.Word{c.Word(c.ADC_I), 0x01},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x00,
+			ExpectedProcessorStatusValue: 0b10001001,
+		},
+	}
+
+	for idx, testData := range data {
+		testData.Name = strconv.Itoa(idx+1) + "_" + testData.Name
+		testData.Run(t, cpu, tm)
+	}
+}
+
+func TestSBC(t *testing.T) {
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	tm := ut.DefaultTestMemory(t)
+
+	data := []ut.InstructionTestData{
+		{
+			Name:                         "Without borrow",
+			AccumolatorSetup:             0x05,
+			ProcessorStatusSetup:         0b00000001,
+			MemorySetup:                  []c.Word{c.Word(c.SBC_I), 0x03},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x02,
+			ExpectedProcessorStatusValue: 0b00000001,
+		},
+		{
+			Name:                         "With borrow",
+			AccumolatorSetup:             0x05,
+			MemorySetup:                  []c.Word{c.Word(c.SBC_I), 0x05},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0xFF,
+			ExpectedProcessorStatusValue: 0b10000000,
+		},
+		{
+			Name:                         "Zero result",
+			AccumolatorSetup:             0x05,
+			ProcessorStatusSetup:         0b00000001,
+			MemorySetup:                  []c.Word{c.Word(c.SBC_Z), 0x10, 0x05},
+			ExpectToAdvancedCycles:       3,
+			ExpectAccumulatorValue:       0x00,
+			ExpectedProcessorStatusValue: 0b00000011,
+		},
+		{
+			Name:                         "Overflow",
+			AccumolatorSetup:             0x50,
+			ProcessorStatusSetup:         0b00000001,
+			MemorySetup:                  []c.Word{c.Word(c.SBC_I), 0xB0},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0xA0,
+			ExpectedProcessorStatusValue: 0b11000000,
+		},
+		{
+			Name:                         "Decimal",
+			AccumolatorSetup:             0x10,
+			ProcessorStatusSetup:         0b00001001,
+			MemorySetup:                  []c.Word{c.Word(c.SBC_I), 0x01},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x09,
+			ExpectedProcessorStatusValue: 0b00001001,
+		},
+		{
+			Name:                         "Decimal with borrow out",
+			AccumolatorSetup:             0x00,
+			ProcessorStatusSetup:         0b00001001,
+			MemorySetup:                  []c.Word{c.Word(c.SBC_I), 0x01},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x99,
+			ExpectedProcessorStatusValue: 0b10001000,
+		},
+	}
+
+	for idx, testData := range data {
+		testData.Name = strconv.Itoa(idx+1) + "_" + testData.Name
+		testData.Run(t, cpu, tm)
+	}
+}
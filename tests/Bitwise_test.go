@@ -0,0 +1,151 @@
+package tests_test
+
+import (
+	"strconv"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestShiftAndLogicOnAccumulator(t *testing.T) {
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	tm := ut.DefaultTestMemory(t)
+
+	data := []ut.InstructionTestData{
+		{
+			Name:                         "ASL into carry",
+			AccumolatorSetup:             0x81,
+			MemorySetup:                  []c.Word{c.Word(c.ASL_A), 0x00},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x02,
+			ExpectedProcessorStatusValue: 0b00000001,
+		},
+		{
+			Name:                         "LSR to zero",
+			AccumolatorSetup:             0x01,
+			MemorySetup:                  []c.Word{c.Word(c.LSR_A), 0x00},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x00,
+			ExpectedProcessorStatusValue: 0b00000011,
+		},
+		{
+			Name:                         "ROL through carry",
+			AccumolatorSetup:             0x40,
+			ProcessorStatusSetup:         0b00000001,
+			MemorySetup:                  []c.Word{c.Word(c.ROL_A), 0x00},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x81,
+			ExpectedProcessorStatusValue: 0b10000000,
+		},
+		{
+			Name:                         "ROR through carry",
+			AccumolatorSetup:             0x01,
+			ProcessorStatusSetup:         0b00000001,
+			MemorySetup:                  []c.Word{c.Word(c.ROR_A), 0x00},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x80,
+			ExpectedProcessorStatusValue: 0b10000001,
+		},
+		{
+			Name:                         "AND",
+			AccumolatorSetup:             0xF0,
+			MemorySetup:                  []c.Word{c.Word(c.AND_I), 0x0F},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0x00,
+			ExpectedProcessorStatusValue: 0b00000010,
+		},
+		{
+			Name:                         "ORA",
+			AccumolatorSetup:             0xF0,
+			MemorySetup:                  []c.Word{c.Word(c.ORA_Z), 0x10, 0x0F},
+			ExpectToAdvancedCycles:       3,
+			ExpectAccumulatorValue:       0xFF,
+			ExpectedProcessorStatusValue: 0b10000000,
+		},
+		{
+			Name:                         "EOR",
+			AccumolatorSetup:             0xFF,
+			MemorySetup:                  []c.Word{c.Word(c.EOR_I), 0x0F},
+			ExpectToAdvancedCycles:       2,
+			ExpectAccumulatorValue:       0xF0,
+			ExpectedProcessorStatusValue: 0b10000000,
+		},
+		{
+			Name:                         "BIT copies bit 7 and 6",
+			AccumolatorSetup:             0x01,
+			MemorySetup:                  []c.Word{c.Word(c.BIT_Z), 0x10, 0xC1},
+			ExpectToAdvancedCycles:       3,
+			ExpectAccumulatorValue:       0x01,
+			ExpectedProcessorStatusValue: 0b11000000,
+		},
+		{
+			Name:                         "BIT sets zero",
+			AccumolatorSetup:             0x01,
+			MemorySetup:                  []c.Word{c.Word(c.BIT_ABS), 0x00, 0x20, 0x02},
+			ExpectToAdvancedCycles:       4,
+			ExpectAccumulatorValue:       0x01,
+			ExpectedProcessorStatusValue: 0b00000010,
+		},
+	}
+
+	for idx, testData := range data {
+		testData.Name = strconv.Itoa(idx+1) + "_" + testData.Name
+		testData.Run(t, cpu, tm)
+	}
+}
+
+// writeRecorder remembers every write so tests can check dummy writes.
+type writeRecorder struct {
+	c.Memory16K
+	writes []c.Word
+}
+
+func (mem *writeRecorder) WriteWord(destination c.Address, value c.Word) {
+	mem.writes = append(mem.writes, value)
+	mem.Memory16K.WriteWord(destination, value)
+}
+
+func TestReadModifyWrite(t *testing.T) {
+	data := []struct {
+		name    string
+		x       c.Word
+		program []c.Word
+		address c.Address
+		value   c.Word
+		result  c.Word
+		cycles  uint
+	}{
+		{"ASL_Z", 0, []c.Word{c.Word(c.ASL_Z), 0x10}, 0x10, 0x41, 0x82, 5},
+		{"ASL_ZX", 1, []c.Word{c.Word(c.ASL_ZX), 0x10}, 0x11, 0x41, 0x82, 6},
+		{"LSR_ABS", 0, []c.Word{c.Word(c.LSR_ABS), 0x00, 0x20}, 0x2000, 0x82, 0x41, 6},
+		{"ROL_ABSX", 1, []c.Word{c.Word(c.ROL_ABSX), 0x00, 0x20}, 0x2001, 0x41, 0x82, 7},
+		{"ROR_ABSX page crossed", 1, []c.Word{c.Word(c.ROR_ABSX), 0xFF, 0x20}, 0x2100, 0x82, 0x41, 7},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			mem := &writeRecorder{}
+			_ = mem.Init()
+			for idx, word := range d.program {
+				mem.Memory16K.WriteWord(0x0200+c.Address(idx), word)
+			}
+			mem.Memory16K.WriteWord(d.address, d.value)
+
+			cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+			cpu.ProgramCounter = 0x0200
+			cpu.RegisterX = d.x
+			cpu.Execute(1, mem, true)
+
+			if got := mem.ReadWord(d.address); got != d.result {
+				t.Fatalf("Expected %v at %v but got %v", d.result, d.address, got)
+			}
+			if len(mem.writes) != 2 || mem.writes[0] != d.value || mem.writes[1] != d.result {
+				t.Fatalf("Expected the dummy write of %v followed by %v but got %v", d.value, d.result, mem.writes)
+			}
+			if cpu.Cycle != d.cycles {
+				t.Fatalf("Expected %d cycles but took %d", d.cycles, cpu.Cycle)
+			}
+		})
+	}
+}
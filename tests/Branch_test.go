@@ -0,0 +1,52 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestBranch(t *testing.T) {
+	data := []struct {
+		name        string
+		instruction c.Instruction
+		status      c.Word
+		start       c.Address
+		offset      c.Word
+		expectPC    c.Address
+		cycles      uint
+	}{
+		{"BCC not taken", c.BCC, 0b00000001, 0x0200, 0x10, 0x0202, 2},
+		{"BCC taken", c.BCC, 0b00000000, 0x0200, 0x10, 0x0212, 3},
+		{"BCS taken", c.BCS, 0b00000001, 0x0200, 0x10, 0x0212, 3},
+		{"BEQ taken backwards", c.BEQ, 0b00000010, 0x0210, 0xF0, 0x0202, 3},
+		{"BNE not taken", c.BNE, 0b00000010, 0x0200, 0x10, 0x0202, 2},
+		{"BNE taken across a page", c.BNE, 0b00000000, 0x02F0, 0x10, 0x0302, 4},
+		{"BNE taken backwards across a page", c.BNE, 0b00000000, 0x0200, 0xFC, 0x01FE, 4},
+		{"BMI taken", c.BMI, 0b10000000, 0x0200, 0x01, 0x0203, 3},
+		{"BPL taken", c.BPL, 0b00000000, 0x0200, 0x01, 0x0203, 3},
+		{"BPL not taken", c.BPL, 0b10000000, 0x0200, 0x01, 0x0202, 2},
+		{"BVC taken", c.BVC, 0b00000000, 0x0200, 0x7F, 0x0281, 3},
+		{"BVS taken", c.BVS, 0b01000000, 0x0200, 0x80, 0x0182, 4},
+		{"BVS not taken", c.BVS, 0b00000000, 0x0200, 0x80, 0x0202, 2},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			mem := ut.NewProgramMemory(t, d.start, c.Word(d.instruction), d.offset)
+			cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+			cpu.ProgramCounter = d.start
+			cpu.Status.Status = d.status
+
+			cpu.Execute(1, mem, true)
+
+			if cpu.ProgramCounter != d.expectPC {
+				t.Fatalf("Expected PC %v but got %v", d.expectPC, cpu.ProgramCounter)
+			}
+			if cpu.Cycle != d.cycles {
+				t.Fatalf("Expected %d cycles but took %d", d.cycles, cpu.Cycle)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// TestVerifyCyclesAcceptsEveryOpcode runs every opcode of both models once with all flags cleared and once
+// with all flags set, so every branch is taken once and the 65C02 runs ADC and SBC in decimal mode.
+func TestVerifyCyclesAcceptsEveryOpcode(t *testing.T) {
+	for _, model := range []c.Model{c.MOS6502, c.WDC65C02} {
+		for i := range 256 {
+			instruction := c.Instruction(i)
+			op := model.LookupOpcode(instruction)
+			if !op.Defined() && (model == c.WDC65C02 || !c.LookupUndocumentedOpcode(instruction).Defined()) {
+				continue
+			}
+			for _, s := range timingSetups {
+				for _, status := range []c.Word{0x00, 0xFF} {
+					mem := ut.NewProgramMemory(t, 0x0200, append([]c.Word{c.Word(instruction)}, s.operand...)...)
+					mem.WriteAddress(c.Address(s.operand[0]), s.pointer)
+					cpu := newStackTestCpu(t)
+					cpu.Model = model
+					cpu.UndocumentedOpcodes = true
+					cpu.VerifyCycles = true
+					cpu.RegisterX = 0x20
+					cpu.RegisterY = 0x20
+					cpu.Status.Status = status
+
+					var jam *c.JamError
+					if _, err := cpu.Step(mem); err != nil && !errors.As(err, &jam) {
+						t.Errorf("%s %s %s status %#08b: %v", model, instruction, s.name, status, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestVerifyCyclesAcceptsBranchToNextInstruction(t *testing.T) {
+	for _, value := range []c.Word{0x00, 0x01} {
+		for _, instruction := range []c.Instruction{c.BBR0, c.BBS0} {
+			mem := ut.NewProgramMemory(t, 0x0200, c.Word(instruction), 0x10, 0x00)
+			mem.WriteWord(0x0010, value)
+			cpu := newCMOSTestCpu(t)
+			cpu.VerifyCycles = true
+
+			if _, err := cpu.Step(mem); err != nil {
+				t.Errorf("%s with %s at $10: %v", instruction, value, err)
+			}
+		}
+	}
+}
+
+// TestVerifyCyclesRejectsExtraAccess makes LDA absolute access the bus once more than the opcode table
+// allows for, through a watch that reads from the bus in the middle of the instruction.
+func TestVerifyCyclesRejectsExtraAccess(t *testing.T) {
+	for _, verify := range []bool{true, false} {
+		mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDA_ABS), 0x34, 0x12)
+		mem.WriteWord(0x1234, 0x42)
+		cpu := newStackTestCpu(t)
+		cpu.VerifyCycles = verify
+		cpu.Watch(c.WatchRead, 0x1234, 0x1234, func(c.WatchEvent) {
+			cpu.FetchWord(mem, 0x0000)
+		})
+
+		cycles, err := cpu.Step(mem)
+		var verification *c.CycleVerificationError
+		if verify {
+			if !errors.As(err, &verification) {
+				t.Fatalf("Expected a CycleVerificationError but got %v", err)
+			}
+			if verification.Expected != 4 || verification.Actual != 5 || verification.Opcode != c.LDA_ABS || verification.ProgramCounter != 0x0200 {
+				t.Fatalf("Expected LDA_ABS at 0x0200 to take 5 cycles instead of 4 but got %+v", *verification)
+			}
+		} else if err != nil {
+			t.Fatalf("Expected no error without VerifyCycles but got %v", err)
+		}
+		if cycles != 5 || cpu.Accumulator != 0x42 || cpu.ProgramCounter != 0x0203 {
+			t.Fatalf("Expected the instruction to complete in 5 cycles either way but took %d\n%s", cycles, cpu.Short())
+		}
+	}
+}
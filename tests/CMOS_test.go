@@ -0,0 +1,232 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// publishedCMOSCycles are the base cycle counts of the W65C02S as published in the WDC data sheet.
+var publishedCMOSCycles = [256]uint{
+	7, 6, 2, 1, 5, 3, 5, 5, 3, 2, 2, 1, 6, 4, 6, 5, // 0x00
+	2, 5, 5, 1, 5, 4, 6, 5, 2, 4, 2, 1, 6, 4, 6, 5, // 0x10
+	6, 6, 2, 1, 3, 3, 5, 5, 4, 2, 2, 1, 4, 4, 6, 5, // 0x20
+	2, 5, 5, 1, 4, 4, 6, 5, 2, 4, 2, 1, 4, 4, 6, 5, // 0x30
+	6, 6, 2, 1, 3, 3, 5, 5, 3, 2, 2, 1, 3, 4, 6, 5, // 0x40
+	2, 5, 5, 1, 4, 4, 6, 5, 2, 4, 3, 1, 8, 4, 6, 5, // 0x50
+	6, 6, 2, 1, 3, 3, 5, 5, 4, 2, 2, 1, 6, 4, 6, 5, // 0x60
+	2, 5, 5, 1, 4, 4, 6, 5, 2, 4, 4, 1, 6, 4, 6, 5, // 0x70
+	3, 6, 2, 1, 3, 3, 3, 5, 2, 2, 2, 1, 4, 4, 4, 5, // 0x80
+	2, 6, 5, 1, 4, 4, 4, 5, 2, 5, 2, 1, 4, 5, 5, 5, // 0x90
+	2, 6, 2, 1, 3, 3, 3, 5, 2, 2, 2, 1, 4, 4, 4, 5, // 0xA0
+	2, 5, 5, 1, 4, 4, 4, 5, 2, 4, 2, 1, 4, 4, 4, 5, // 0xB0
+	2, 6, 2, 1, 3, 3, 5, 5, 2, 2, 2, 3, 4, 4, 6, 5, // 0xC0
+	2, 5, 5, 1, 4, 4, 6, 5, 2, 4, 3, 3, 4, 4, 7, 5, // 0xD0
+	2, 6, 2, 1, 3, 3, 5, 5, 2, 2, 2, 1, 4, 4, 6, 5, // 0xE0
+	2, 5, 5, 1, 4, 4, 6, 5, 2, 4, 4, 1, 4, 4, 7, 5, // 0xF0
+}
+
+// publishedCMOSPageCross are the opcodes the data sheet marks with "+1 if page boundary is crossed".
+// Branches are left out.
+var publishedCMOSPageCross = map[c.Instruction]bool{
+	c.ADC_ABSX: true, c.ADC_ABSY: true, c.ADC_INDY: true,
+	c.AND_ABSX: true, c.AND_ABSY: true, c.AND_INDY: true,
+	c.ASL_ABSX: true, c.BIT_ABSX: true,
+	c.CMP_ABSX: true, c.CMP_ABSY: true, c.CMP_INDY: true,
+	c.EOR_ABSX: true, c.EOR_ABSY: true, c.EOR_INDY: true,
+	c.LDA_ABSX: true, c.LDA_ABSY: true, c.LDA_INDY: true,
+	c.LDX_ABSY: true, c.LDY_ABSX: true, c.LSR_ABSX: true,
+	c.ORA_ABSX: true, c.ORA_ABSY: true, c.ORA_INDY: true,
+	c.ROL_ABSX: true, c.ROR_ABSX: true,
+	c.SBC_ABSX: true, c.SBC_ABSY: true, c.SBC_INDY: true,
+}
+
+func newCMOSTestCpu(t *testing.T) *c.SixFiveOTwo {
+	cpu := newStackTestCpu(t)
+	cpu.Model = c.WDC65C02
+	return cpu
+}
+
+func TestCMOSOpcodeTableMatchesPublishedCycles(t *testing.T) {
+	for i := range 256 {
+		op := c.WDC65C02.LookupOpcode(c.Instruction(i))
+		if !op.Defined() {
+			t.Errorf("%#02x is missing from the 65C02 opcode table", i)
+			continue
+		}
+		if uint(op.Cycles) != publishedCMOSCycles[i] {
+			t.Errorf("%#02x %s: table has %d cycles, published are %d", i, op.Mnemonic, op.Cycles, publishedCMOSCycles[i])
+		}
+	}
+}
+
+func TestCMOSMeasuredCyclesMatchPublishedCycles(t *testing.T) {
+	for i := range 256 {
+		instruction := c.Instruction(i)
+		op := c.WDC65C02.LookupOpcode(instruction)
+		if op.Mode == c.Relative || op.Mode == c.ZeroPageRelative || op.Mnemonic == "STP" {
+			continue
+		}
+		for _, s := range timingSetups {
+			t.Run(op.Mnemonic+" "+op.Mode.String()+" "+s.name, func(t *testing.T) {
+				mem := ut.NewProgramMemory(t, 0x0200, append([]c.Word{c.Word(instruction)}, s.operand...)...)
+				mem.WriteAddress(c.Address(s.operand[0]), s.pointer)
+				cpu := newCMOSTestCpu(t)
+				cpu.RegisterX = 0x20
+				cpu.RegisterY = 0x20
+
+				cycles, err := cpu.Step(mem)
+				if err != nil {
+					t.Fatal(err)
+				}
+				expected := publishedCMOSCycles[i]
+				if s.crossed && publishedCMOSPageCross[instruction] {
+					expected++
+				}
+				if cycles != expected {
+					t.Errorf("Expected %d cycles but took %d", expected, cycles)
+				}
+			})
+		}
+	}
+}
+
+func TestCMOSInstructions(t *testing.T) {
+	data := []struct {
+		name                                      string
+		program                                   []c.Word
+		a, x, y, status, memory                   c.Word
+		expectedA, expectedStatus, expectedMemory uint8
+		expectedPC                                c.Address
+		expectedCycles                            uint
+	}{
+		{"STZ", []c.Word{c.Word(c.STZ_Z), 0x10}, 0x12, 0, 0, 0, 0xFF, 0x12, 0, 0x00, 0x0202, 3},
+		{"TSB", []c.Word{c.Word(c.TSB_Z), 0x10}, 0x0F, 0, 0, 0, 0xF0, 0x0F, 0b00000010, 0xFF, 0x0202, 5},
+		{"TRB", []c.Word{c.Word(c.TRB_Z), 0x10}, 0x0F, 0, 0, 0, 0xFF, 0x0F, 0b00000000, 0xF0, 0x0202, 5},
+		{"RMB3", []c.Word{c.Word(c.RMB3_Z), 0x10}, 0, 0, 0, 0, 0xFF, 0, 0, 0xF7, 0x0202, 5},
+		{"SMB7", []c.Word{c.Word(c.SMB7_Z), 0x10}, 0, 0, 0, 0, 0x00, 0, 0, 0x80, 0x0202, 5},
+		{"BBR0 taken", []c.Word{c.Word(c.BBR0), 0x10, 0x10}, 0, 0, 0, 0, 0xFE, 0, 0, 0xFE, 0x0213, 6},
+		{"BBR0 not taken", []c.Word{c.Word(c.BBR0), 0x10, 0x10}, 0, 0, 0, 0, 0x01, 0, 0, 0x01, 0x0203, 5},
+		{"BBS7 taken", []c.Word{c.Word(c.BBS7), 0x10, 0xFC}, 0, 0, 0, 0, 0x80, 0, 0, 0x80, 0x01FF, 7},
+		{"BIT immediate", []c.Word{c.Word(c.BIT_I), 0xC0}, 0x01, 0, 0, 0b00000001, 0, 0x01, 0b00000011, 0, 0x0202, 2},
+		{"INC A", []c.Word{c.Word(c.INC_A)}, 0xFF, 0, 0, 0, 0, 0x00, 0b00000010, 0, 0x0201, 2},
+		{"DEC A", []c.Word{c.Word(c.DEC_A)}, 0x00, 0, 0, 0, 0, 0xFF, 0b10000000, 0, 0x0201, 2},
+		{"LDA (zp)", []c.Word{c.Word(c.LDA_ZIND), 0x20}, 0, 0, 0, 0, 0, 0x42, 0, 0, 0x0202, 5},
+		{"BRA", []c.Word{c.Word(c.BRA), 0x02}, 0, 0, 0, 0, 0, 0, 0, 0, 0x0204, 3},
+		{"JMP (abs,X)", []c.Word{c.Word(c.JMP_INDABSX), 0x00, 0x30}, 0, 0x02, 0, 0, 0, 0, 0, 0, 0x5678, 6},
+		{"JMP (abs) without page bug", []c.Word{c.Word(c.JMP_IND), 0xFF, 0x30}, 0, 0, 0, 0, 0, 0, 0, 0, 0x1234, 6},
+		{"ADC decimal sets valid flags", []c.Word{c.Word(c.ADC_I), 0x01}, 0x99, 0, 0, 0b00001000, 0, 0x00, 0b00001011, 0, 0x0202, 3},
+		{"SBC decimal sets valid flags", []c.Word{c.Word(c.SBC_I), 0x01}, 0x00, 0, 0, 0b00001001, 0, 0x99, 0b10001000, 0, 0x0202, 3},
+		{"reserved NOP", []c.Word{c.Word(c.NOP_03)}, 0, 0, 0, 0, 0, 0, 0, 0, 0x0201, 1},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			assert := ut.AssertHelperNew(t)
+			mem := ut.NewProgramMemory(t, 0x0200, d.program...)
+			mem.WriteWord(0x0010, d.memory)
+			mem.WriteAddress(0x0020, 0x4000)
+			mem.WriteWord(0x4000, 0x42)
+			mem.WriteAddress(0x3002, 0x5678)
+			mem.WriteAddress(0x30FF, 0x1234)
+			cpu := newCMOSTestCpu(t)
+			cpu.Accumulator = d.a
+			cpu.RegisterX = d.x
+			cpu.RegisterY = d.y
+			cpu.Status.Status = d.status
+
+			if _, err := cpu.Step(mem); err != nil {
+				t.Fatal(err)
+			}
+			assert.AssertEqualsUint8(d.expectedA, cpu.Accumulator, "Wrong accumulator %v")
+			assert.AssertEqualsUint8(d.expectedStatus, cpu.Status, "Wrong status \n%v")
+			assert.AssertEqualsUint8(d.expectedMemory, mem.ReadWord(0x0010), "Wrong memory %v")
+			assertProgramCounter(t, cpu, d.expectedPC)
+			assertCycles(t, cpu, d.expectedCycles)
+		})
+	}
+}
+
+func TestCMOSPushAndPullIndexRegisters(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.PHX), c.Word(c.PHY), c.Word(c.PLX), c.Word(c.PLY))
+	cpu := newCMOSTestCpu(t)
+	cpu.RegisterX = 0x11
+	cpu.RegisterY = 0x22
+
+	if err := cpu.Execute(14, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 14)
+	if cpu.RegisterX != 0x22 || cpu.RegisterY != 0x11 {
+		t.Fatalf("Expected X and Y to be swapped but got %v %v", cpu.RegisterX, cpu.RegisterY)
+	}
+}
+
+func TestCMOSInterruptClearsDecimalFlag(t *testing.T) {
+	mem := newInterruptTestMemory(t, c.Word(c.BRK), 0x00)
+	cpu := newCMOSTestCpu(t)
+	cpu.Status.SetDecimalFlag(true)
+
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	assertProgramCounter(t, cpu, irqHandler)
+	if cpu.Status.GetDecimalFlag() != 0 {
+		t.Fatalf("The 65C02 has to clear the decimal flag on interrupts")
+	}
+	if mem.ReadWord(0x01FD)&0b00001000 == 0 {
+		t.Fatalf("The pushed status has to keep the decimal flag")
+	}
+}
+
+func TestCMOSWaitForInterrupt(t *testing.T) {
+	mem := newInterruptTestMemory(t, c.Word(c.WAI), c.Word(c.LDX_I), 0x01)
+	cpu := newCMOSTestCpu(t)
+	cpu.Status.SetInterruptDisableFlag(true)
+
+	if err := cpu.Execute(10, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assertProgramCounter(t, cpu, 0x0201)
+
+	// With interrupts disabled the IRQ only wakes the CPU up
+	cpu.AssertIRQ()
+	if err := cpu.Execute(1, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assertProgramCounter(t, cpu, 0x0203)
+	if cpu.RegisterX != 0x01 {
+		t.Fatalf("Execution has to continue after WAI")
+	}
+}
+
+func TestCMOSStopHaltsUntilReset(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.STP))
+	cpu := newCMOSTestCpu(t)
+
+	_, err := cpu.Step(mem)
+	var jam *c.JamError
+	if !errors.As(err, &jam) || jam.Opcode != c.STP {
+		t.Fatalf("Expected the CPU to stop but got %v", err)
+	}
+	assertCycles(t, cpu, 3)
+	if _, err := cpu.Step(mem); !errors.As(err, &jam) {
+		t.Fatalf("A stopped CPU must stay stopped but got %v", err)
+	}
+}
+
+func TestCMOSIgnoresUndocumentedOpcodes(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LAX_Z), 0x10)
+	cpu := newCMOSTestCpu(t)
+	cpu.UndocumentedOpcodes = true
+
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	// $A7 is SMB2 on the 65C02
+	if mem.ReadWord(0x0010) != 0x04 || cpu.Accumulator != 0 {
+		t.Fatalf("Expected SMB2 to be executed")
+	}
+}
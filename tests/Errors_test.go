@@ -0,0 +1,79 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+const illegalOpcode c.Word = 0x02
+
+func TestIllegalOpcodeHalts(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDX_I), 0x01, illegalOpcode, c.Word(c.LDX_I), 0x02)
+	cpu := newStackTestCpu(t)
+
+	err := cpu.Execute(0, mem, true)
+
+	var illegal *c.IllegalOpcodeError
+	if !errors.As(err, &illegal) {
+		t.Fatalf("Expected an IllegalOpcodeError but got %v", err)
+	}
+	if illegal.ProgramCounter != 0x0202 || illegal.Opcode != c.Instruction(illegalOpcode) {
+		t.Fatalf("Wrong error %v", illegal)
+	}
+	assertProgramCounter(t, cpu, 0x0202)
+	if cpu.RegisterX != 0x01 {
+		t.Fatalf("Execution has to stop at the illegal opcode")
+	}
+}
+
+func TestIllegalOpcodeAsNop(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, illegalOpcode, c.Word(c.LDX_I), 0x02)
+	cpu := newStackTestCpu(t)
+	cpu.IllegalOpcodePolicy = c.NopOnIllegalOpcode
+
+	if err := cpu.Execute(1, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 2)
+	assertProgramCounter(t, cpu, 0x0201)
+}
+
+func TestIllegalOpcodeCallback(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, illegalOpcode, illegalOpcode)
+	cpu := newStackTestCpu(t)
+	cpu.IllegalOpcodePolicy = c.CallbackOnIllegalOpcode
+
+	stop := errors.New("stop")
+	var seen []c.Address
+	cpu.OnIllegalOpcode = func(cpu *c.SixFiveOTwo, err *c.IllegalOpcodeError) error {
+		seen = append(seen, err.ProgramCounter)
+		if len(seen) == 2 {
+			return stop
+		}
+		return nil
+	}
+
+	err := cpu.Execute(0, mem, true)
+	if !errors.Is(err, stop) {
+		t.Fatalf("Expected the error of the callback but got %v", err)
+	}
+	if len(seen) != 2 || seen[0] != 0x0200 || seen[1] != 0x0201 {
+		t.Fatalf("Callback was called for %v", seen)
+	}
+}
+
+func TestAssertCycle(t *testing.T) {
+	cpu := newStackTestCpu(t)
+	cpu.Cycle = 3
+
+	if err := cpu.AssertCycle(3); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	var mismatch *c.CycleMismatchError
+	if err := cpu.AssertCycle(4); !errors.As(err, &mismatch) || mismatch.Expected != 4 || mismatch.Actual != 3 {
+		t.Fatalf("Expected a CycleMismatchError but got %v", err)
+	}
+}
@@ -19,7 +19,7 @@ func TestADC_ZX(t *testing.T) {
 			AccumolatorSetup:             0x10,
 			RegisterXSetup:               0,
 			RegisterYSetup:               0,
-			MemorySetup:                  []c.Word{c.Word(c.ADC_ZX), 0x00, 0x0F},
+			MemorySetup:                  []c.Word{c.Word(c.ADC_ZX), 0x00, 0x00, 0x0F},
 			ExpectToAdvancedCycles:       4,
 			ExpectAccumulatorValue:       0x1F,
 			ExpectedProcessorStatusValue: 0b00000000,
@@ -29,7 +29,7 @@ func TestADC_ZX(t *testing.T) {
 			AccumolatorSetup:             0x10,
 			RegisterXSetup:               0,
 			RegisterYSetup:               0,
-			MemorySetup:                  []c.Word{c.Word(c.ADC_ZX), 0x00, 0xF1},
+			MemorySetup:                  []c.Word{c.Word(c.ADC_ZX), 0x00, 0x00, 0xF1},
 			ExpectToAdvancedCycles:       4,
 			ExpectAccumulatorValue:       0x01,
 			ExpectedProcessorStatusValue: 0b00000001,
@@ -39,7 +39,7 @@ func TestADC_ZX(t *testing.T) {
 			AccumolatorSetup:       0x10,
 			RegisterXSetup:         0,
 			RegisterYSetup:         0,
-			MemorySetup:            []c.Word{c.Word(c.ADC_ZX), 0x00, 0xF0},
+			MemorySetup:            []c.Word{c.Word(c.ADC_ZX), 0x00, 0x00, 0xF0},
 			ExpectToAdvancedCycles: 4,
 			ExpectAccumulatorValue: 0x00,
 			// TODO check if carry is should be set?
@@ -58,7 +58,9 @@ func TestADC_ZX(t *testing.T) {
 		cpu.Accumulator = 0xFF
 		cpu.RegisterX = 0x00
 		cpu.Execute(1, tm, true)
-		cpu.AssertCycle(12)
+		if err := cpu.AssertCycle(12); err != nil {
+			t2.Fatal(err)
+		}
 		th.AssertEqualsUint8(0x00, cpu.Accumulator, "Wrong Accumulator state")
 		th.AssertEqualsUint8(0b10000000, cpu.Status, "Wrong Status register \n%v")
 	})
@@ -70,6 +72,8 @@ func TestADC_ZX(t *testing.T) {
 		cpu.Execute(1, tm, true)
 		th.AssertEqualsUint8(0x00, cpu.Accumulator, "Wrong Accumulator state")
 		th.AssertEqualsUint8(0b10000010, cpu.Status, "Wrong Status register \nExpect: %#08b\n%v", 0b10000010)
-		cpu.AssertCycle(20)
+		if err := cpu.AssertCycle(20); err != nil {
+			t2.Fatal(err)
+		}
 	})
 }
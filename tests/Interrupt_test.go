@@ -0,0 +1,120 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+const (
+	irqHandler c.Address = 0x4000
+	nmiHandler c.Address = 0x5000
+)
+
+func newInterruptTestMemory(t *testing.T, program ...c.Word) *c.Memory16K {
+	mem := ut.NewProgramMemory(t, 0x0200, program...)
+	mem.WriteAddress(c.IRQVector, irqHandler)
+	mem.WriteAddress(c.NMIVector, nmiHandler)
+	mem.WriteWord(irqHandler, c.Word(c.RTI))
+	mem.WriteWord(nmiHandler, c.Word(c.RTI))
+	return mem
+}
+
+func assertProgramCounter(t *testing.T, cpu *c.SixFiveOTwo, expected c.Address) {
+	t.Helper()
+	if cpu.ProgramCounter != expected {
+		t.Fatalf("Expected PC %v but got %v", expected, cpu.ProgramCounter)
+	}
+}
+
+func TestBreakAndReturnFromInterrupt(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := newInterruptTestMemory(t, c.Word(c.BRK), 0xEA, c.Word(c.LDX_I), 0x01)
+	cpu := newStackTestCpu(t)
+	cpu.Status.Status = 0b10000001
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 7)
+	assertProgramCounter(t, cpu, irqHandler)
+	assert.AssertEqualsUint8(0x02, mem.ReadWord(0x01FF), "Wrong high byte of the return address %v")
+	assert.AssertEqualsUint8(0x02, mem.ReadWord(0x01FE), "BRK has to skip the padding byte but pushed %v")
+	assert.AssertEqualsUint8(0b10110001, mem.ReadWord(0x01FD), "BRK has to push the status with B set but pushed %v")
+	assert.AssertEqualsUint8(0b10000101, cpu.Status, "BRK has to set the interrupt disable flag \n%v")
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 13)
+	assertProgramCounter(t, cpu, 0x0202)
+	assert.AssertEqualsUint8(0b10000001, cpu.Status, "RTI has to restore the status \n%v")
+	assert.AssertEqualsUint8(0xFF, cpu.StackPointer, "Wrong StackPointer %v")
+}
+
+func TestIRQ(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := newInterruptTestMemory(t, c.Word(c.LDX_I), 0x01, c.Word(c.LDX_I), 0x02)
+	cpu := newStackTestCpu(t)
+	cpu.Status.SetInterruptDisableFlag(true)
+	cpu.AssertIRQ()
+
+	cpu.Execute(1, mem, true)
+	assertProgramCounter(t, cpu, 0x0202)
+	assert.AssertEqualsUint8(0x01, cpu.RegisterX, "IRQ must be ignored while interrupts are disabled %v")
+
+	cpu.Status.SetInterruptDisableFlag(false)
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 9)
+	assertProgramCounter(t, cpu, irqHandler)
+	assert.AssertEqualsUint8(0b00100000, mem.ReadWord(0x01FD), "IRQ has to push the status with B clear but pushed %v")
+
+	// The handler returns with the line still asserted, the IRQ is taken again
+	cpu.Execute(1, mem, true)
+	assertProgramCounter(t, cpu, 0x0202)
+	cpu.Execute(1, mem, true)
+	assertProgramCounter(t, cpu, irqHandler)
+
+	cpu.ReleaseIRQ()
+	cpu.Execute(1, mem, true)
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0x02, cpu.RegisterX, "Execution has to continue once the IRQ line is released %v")
+}
+
+func TestNMI(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := newInterruptTestMemory(t, c.Word(c.LDX_I), 0x01, c.Word(c.LDX_I), 0x02)
+	cpu := newStackTestCpu(t)
+	cpu.Status.SetInterruptDisableFlag(true)
+	cpu.TriggerNMI()
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 7)
+	assertProgramCounter(t, cpu, nmiHandler)
+
+	cpu.Execute(1, mem, true)
+	assertProgramCounter(t, cpu, 0x0200)
+
+	// The edge was consumed
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0x01, cpu.RegisterX, "NMI has to be taken only once %v")
+}
+
+// nmiOnWrite triggers an NMI as soon as the CPU writes to the stack.
+type nmiOnWrite struct {
+	*c.Memory16K
+	cpu *c.SixFiveOTwo
+}
+
+func (mem nmiOnWrite) WriteWord(destination c.Address, value c.Word) {
+	mem.cpu.TriggerNMI()
+	mem.Memory16K.WriteWord(destination, value)
+}
+
+func TestNMIHijacksBreak(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	cpu := newStackTestCpu(t)
+	mem := nmiOnWrite{Memory16K: newInterruptTestMemory(t, c.Word(c.BRK), 0x00), cpu: cpu}
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 7)
+	assertProgramCounter(t, cpu, nmiHandler)
+	assert.AssertEqualsUint8(0b00110000, mem.ReadWord(0x01FD), "The hijacked BRK still pushes B %v")
+}
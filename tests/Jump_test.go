@@ -0,0 +1,45 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestJump(t *testing.T) {
+	data := []struct {
+		name    string
+		program []c.Word
+		memory  map[c.Address]c.Word
+		target  c.Address
+		cycles  uint
+	}{
+		{"JMP_ABS", []c.Word{c.Word(c.JMP_ABS), 0x34, 0x12}, nil, 0x1234, 3},
+		{"JMP_IND", []c.Word{c.Word(c.JMP_IND), 0x00, 0x30}, map[c.Address]c.Word{0x3000: 0x78, 0x3001: 0x56}, 0x5678, 5},
+		{
+			// The high byte is read from the start of the page the pointer is in
+			"JMP_IND page wrap",
+			[]c.Word{c.Word(c.JMP_IND), 0xFF, 0x30},
+			map[c.Address]c.Word{0x30FF: 0x78, 0x3000: 0x56, 0x3100: 0x99},
+			0x5678,
+			5,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			mem := ut.NewProgramMemory(t, 0x0200, d.program...)
+			for address, value := range d.memory {
+				mem.WriteWord(address, value)
+			}
+			cpu := newStackTestCpu(t)
+
+			if _, err := cpu.Step(mem); err != nil {
+				t.Fatal(err)
+			}
+			assertProgramCounter(t, cpu, d.target)
+			assertCycles(t, cpu, d.cycles)
+		})
+	}
+}
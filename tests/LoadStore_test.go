@@ -0,0 +1,96 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+type loadStoreTestData struct {
+	name    string
+	x, y    c.Word
+	program []c.Word
+	// memory is written before the program runs
+	memory map[c.Address]c.Word
+	cycles uint
+	// register is the register the instruction loads, nil for stores
+	register func(cpu *c.SixFiveOTwo) c.Word
+	expected c.Word
+	status   uint8
+	// stored is the address a store instruction has to write expected to
+	stored c.Address
+}
+
+func accumulator(cpu *c.SixFiveOTwo) c.Word { return cpu.Accumulator }
+func registerX(cpu *c.SixFiveOTwo) c.Word   { return cpu.RegisterX }
+func registerY(cpu *c.SixFiveOTwo) c.Word   { return cpu.RegisterY }
+
+func TestLoadStore(t *testing.T) {
+	data := []loadStoreTestData{
+		{name: "LDA_I", program: []c.Word{c.Word(c.LDA_I), 0x00}, cycles: 2, register: accumulator, expected: 0x00, status: 0b00000010},
+		{name: "LDA_Z", program: []c.Word{c.Word(c.LDA_Z), 0x10}, memory: map[c.Address]c.Word{0x10: 0x42}, cycles: 3, register: accumulator, expected: 0x42},
+		{name: "LDA_ZX", x: 0x02, program: []c.Word{c.Word(c.LDA_ZX), 0x10}, memory: map[c.Address]c.Word{0x12: 0x80}, cycles: 4, register: accumulator, expected: 0x80, status: 0b10000000},
+		{name: "LDA_ABS", program: []c.Word{c.Word(c.LDA_ABS), 0x34, 0x12}, memory: map[c.Address]c.Word{0x1234: 0x01}, cycles: 4, register: accumulator, expected: 0x01},
+		{name: "LDA_ABSX", x: 0x01, program: []c.Word{c.Word(c.LDA_ABSX), 0x34, 0x12}, memory: map[c.Address]c.Word{0x1235: 0x02}, cycles: 4, register: accumulator, expected: 0x02},
+		{name: "LDA_ABSX page crossed", x: 0x01, program: []c.Word{c.Word(c.LDA_ABSX), 0xFF, 0x12}, memory: map[c.Address]c.Word{0x1300: 0x03}, cycles: 5, register: accumulator, expected: 0x03},
+		{name: "LDA_ABSY page crossed", y: 0xFF, program: []c.Word{c.Word(c.LDA_ABSY), 0x01, 0x12}, memory: map[c.Address]c.Word{0x1300: 0x04}, cycles: 5, register: accumulator, expected: 0x04},
+		{name: "LDA_INDX", x: 0x04, program: []c.Word{c.Word(c.LDA_INDX), 0x20}, memory: map[c.Address]c.Word{0x24: 0x00, 0x25: 0x30, 0x3000: 0x05}, cycles: 6, register: accumulator, expected: 0x05},
+		{name: "LDA_INDX pointer wraps in zero page", x: 0x01, program: []c.Word{c.Word(c.LDA_INDX), 0xFE}, memory: map[c.Address]c.Word{0xFF: 0x00, 0x00: 0x30, 0x3000: 0x06}, cycles: 6, register: accumulator, expected: 0x06},
+		{name: "LDA_INDY", y: 0x10, program: []c.Word{c.Word(c.LDA_INDY), 0x20}, memory: map[c.Address]c.Word{0x20: 0x00, 0x21: 0x30, 0x3010: 0x07}, cycles: 5, register: accumulator, expected: 0x07},
+		{name: "LDA_INDY page crossed", y: 0x10, program: []c.Word{c.Word(c.LDA_INDY), 0x20}, memory: map[c.Address]c.Word{0x20: 0xF8, 0x21: 0x30, 0x3108: 0x08}, cycles: 6, register: accumulator, expected: 0x08},
+		{name: "LDX_I", program: []c.Word{c.Word(c.LDX_I), 0xFF}, cycles: 2, register: registerX, expected: 0xFF, status: 0b10000000},
+		{name: "LDX_Z", program: []c.Word{c.Word(c.LDX_Z), 0x10}, memory: map[c.Address]c.Word{0x10: 0x09}, cycles: 3, register: registerX, expected: 0x09},
+		{name: "LDX_ZY", y: 0x01, program: []c.Word{c.Word(c.LDX_ZY), 0x10}, memory: map[c.Address]c.Word{0x11: 0x0A}, cycles: 4, register: registerX, expected: 0x0A},
+		{name: "LDX_ABS", program: []c.Word{c.Word(c.LDX_ABS), 0x00, 0x20}, memory: map[c.Address]c.Word{0x2000: 0x0B}, cycles: 4, register: registerX, expected: 0x0B},
+		{name: "LDX_ABSY page crossed", y: 0x01, program: []c.Word{c.Word(c.LDX_ABSY), 0xFF, 0x20}, memory: map[c.Address]c.Word{0x2100: 0x0C}, cycles: 5, register: registerX, expected: 0x0C},
+		{name: "LDY_I", program: []c.Word{c.Word(c.LDY_I), 0x00}, cycles: 2, register: registerY, expected: 0x00, status: 0b00000010},
+		{name: "LDY_Z", program: []c.Word{c.Word(c.LDY_Z), 0x10}, memory: map[c.Address]c.Word{0x10: 0x0D}, cycles: 3, register: registerY, expected: 0x0D},
+		{name: "LDY_ZX", x: 0x01, program: []c.Word{c.Word(c.LDY_ZX), 0x10}, memory: map[c.Address]c.Word{0x11: 0x0E}, cycles: 4, register: registerY, expected: 0x0E},
+		{name: "LDY_ABS", program: []c.Word{c.Word(c.LDY_ABS), 0x00, 0x20}, memory: map[c.Address]c.Word{0x2000: 0x0F}, cycles: 4, register: registerY, expected: 0x0F},
+		{name: "LDY_ABSX", x: 0x01, program: []c.Word{c.Word(c.LDY_ABSX), 0x00, 0x20}, memory: map[c.Address]c.Word{0x2001: 0x10}, cycles: 4, register: registerY, expected: 0x10},
+
+		{name: "STA_Z", program: []c.Word{c.Word(c.STA_Z), 0x10}, cycles: 3, expected: 0xAA, stored: 0x10},
+		{name: "STA_ZX", x: 0x01, program: []c.Word{c.Word(c.STA_ZX), 0x10}, cycles: 4, expected: 0xAA, stored: 0x11},
+		{name: "STA_ABS", program: []c.Word{c.Word(c.STA_ABS), 0x00, 0x20}, cycles: 4, expected: 0xAA, stored: 0x2000},
+		{name: "STA_ABSX", x: 0x01, program: []c.Word{c.Word(c.STA_ABSX), 0x00, 0x20}, cycles: 5, expected: 0xAA, stored: 0x2001},
+		{name: "STA_ABSX page crossed", x: 0x01, program: []c.Word{c.Word(c.STA_ABSX), 0xFF, 0x20}, cycles: 5, expected: 0xAA, stored: 0x2100},
+		{name: "STA_ABSY", y: 0x02, program: []c.Word{c.Word(c.STA_ABSY), 0x00, 0x20}, cycles: 5, expected: 0xAA, stored: 0x2002},
+		{name: "STA_INDX", x: 0x02, program: []c.Word{c.Word(c.STA_INDX), 0x20}, memory: map[c.Address]c.Word{0x22: 0x00, 0x23: 0x30}, cycles: 6, expected: 0xAA, stored: 0x3000},
+		{name: "STA_INDY", y: 0x03, program: []c.Word{c.Word(c.STA_INDY), 0x20}, memory: map[c.Address]c.Word{0x20: 0x00, 0x21: 0x30}, cycles: 6, expected: 0xAA, stored: 0x3003},
+		{name: "STX_Z", x: 0xBB, program: []c.Word{c.Word(c.STX_Z), 0x10}, cycles: 3, expected: 0xBB, stored: 0x10},
+		{name: "STX_ZY", x: 0xBB, y: 0x01, program: []c.Word{c.Word(c.STX_ZY), 0x10}, cycles: 4, expected: 0xBB, stored: 0x11},
+		{name: "STX_ABS", x: 0xBB, program: []c.Word{c.Word(c.STX_ABS), 0x00, 0x20}, cycles: 4, expected: 0xBB, stored: 0x2000},
+		{name: "STY_Z", y: 0xCC, program: []c.Word{c.Word(c.STY_Z), 0x10}, cycles: 3, expected: 0xCC, stored: 0x10},
+		{name: "STY_ZX", x: 0x01, y: 0xCC, program: []c.Word{c.Word(c.STY_ZX), 0x10}, cycles: 4, expected: 0xCC, stored: 0x11},
+		{name: "STY_ABS", y: 0xCC, program: []c.Word{c.Word(c.STY_ABS), 0x00, 0x20}, cycles: 4, expected: 0xCC, stored: 0x2000},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			assert := ut.AssertHelperNew(t)
+			mem := ut.NewProgramMemory(t, 0x0200, d.program...)
+			for address, value := range d.memory {
+				mem.WriteWord(address, value)
+			}
+			cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+			cpu.ProgramCounter = 0x0200
+			cpu.Accumulator = 0xAA
+			cpu.RegisterX = d.x
+			cpu.RegisterY = d.y
+
+			cpu.Execute(1, mem, true)
+
+			if d.register != nil {
+				assert.AssertEqualsUint8(uint8(d.expected), d.register(cpu), "Wrong register value expected %#02x but got %v", d.expected)
+				assert.AssertEqualsUint8(d.status, cpu.Status, "Wrong Status register expected:\n        %#08b but got: \n%v", d.status)
+			} else {
+				assert.AssertEqualsUint8(uint8(d.expected), mem.ReadWord(d.stored), "Wrong value at %v expected %#02x but got %v", d.stored, d.expected)
+				assert.AssertEqualsUint8(0, cpu.Status, "Stores must not change the Status register but got: \n%v")
+			}
+			if cpu.Cycle != d.cycles {
+				t.Fatalf("Expected %d cycles but took %d", d.cycles, cpu.Cycle)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// register is an I/O Device with a single register that counts its reads.
+type register struct {
+	value c.Word
+	reads int
+}
+
+func (r *register) Read(_ c.Address) c.Word {
+	r.reads++
+	return r.value
+}
+
+func (r *register) Write(_ c.Address, value c.Word) {
+	r.value = value
+}
+
+func TestMemoryMapRoutesToDevices(t *testing.T) {
+	ram := c.NewRAM(0x0800)
+	rom := c.NewRAM(0x1000)
+	io := &register{}
+	mem := c.NewMemoryMap()
+	for _, err := range []error{
+		mem.Map(0x0000, 0x07FF, ram),
+		mem.Map(0xF000, 0xFFFF, rom),
+		mem.Map(0x0400, 0x0400, io),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mem.WriteWord(0x0010, 0x42)
+	mem.WriteWord(0x0400, 0x99)
+	mem.WriteAddress(0xFFFC, 0xF000)
+	if ram[0x0010] != 0x42 || ram[0x0400] != 0x00 || io.value != 0x99 {
+		t.Fatalf("Expected the writes to reach RAM and the register mapped over it")
+	}
+	if rom[0x0FFC] != 0x00 || rom[0x0FFD] != 0xF0 {
+		t.Fatalf("Expected the devices to be addressed with the offset in their region but got %v", rom[0x0FFC:])
+	}
+	if value := mem.ReadWord(0x0400); value != 0x99 || io.reads != 1 {
+		t.Fatalf("Expected to read 0x99 from the register once but got %s after %d reads", value, io.reads)
+	}
+}
+
+func TestMemoryMapReadsOpenBus(t *testing.T) {
+	mem := c.NewMemoryMap()
+	if err := mem.Map(0x0000, 0x00FF, c.NewRAM(0x0100)); err != nil {
+		t.Fatal(err)
+	}
+	mem.WriteWord(0x0010, 0x5A)
+
+	if value := mem.ReadWord(0x0010); value != 0x5A {
+		t.Fatalf("Expected 0x5A but got %s", value)
+	}
+	if value := mem.ReadWord(0x8000); value != 0x5A {
+		t.Fatalf("Expected the unmapped address to return the last value on the bus but got %s", value)
+	}
+	mem.WriteWord(0x9000, 0x33)
+	if value := mem.ReadWord(0x8000); value != 0x33 {
+		t.Fatalf("Expected a write to drive the bus but got %s", value)
+	}
+}
+
+func TestMemoryMapRejectsInvalidRegions(t *testing.T) {
+	mem := c.NewMemoryMap()
+	var mapping *c.MappingError
+	if err := mem.Map(0x2000, 0x1FFF, c.NewRAM(1)); !errors.As(err, &mapping) {
+		t.Fatalf("Expected a MappingError for a region ending before it starts but got %v", err)
+	}
+	if err := mem.Map(0x2000, 0x2000, nil); !errors.As(err, &mapping) {
+		t.Fatalf("Expected a MappingError without a device but got %v", err)
+	}
+	if err := mem.Map(0xE000, 0xFFFF, c.NewROM(make([]byte, 0x1000))); !errors.As(err, &mapping) {
+		t.Fatalf("Expected a MappingError for a ROM smaller than the region but got %v", err)
+	}
+	if value := mem.ReadWord(0xFFFC); value != 0x00 {
+		t.Fatalf("Expected the rejected region to stay unmapped but read %s", value)
+	}
+}
+
+func TestCPURunsOnMemoryMap(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	ram := c.NewRAM(0x8000)
+	rom := c.NewRAM(0x8000)
+	io := &register{}
+	mem := c.NewMemoryMap()
+	_ = mem.Map(0x0000, 0x7FFF, ram)
+	_ = mem.Map(0x8000, 0xFFFF, rom)
+	_ = mem.Map(0x6000, 0x6000, io)
+	if err := mem.Init(); err != nil {
+		t.Fatal(err)
+	}
+	copy(rom, []c.Word{c.Word(c.LDA_I), 0x07, c.Word(c.STA_ABS), 0x00, 0x60, c.Word(c.INC_ABS), 0x00, 0x60, c.Word(c.LDA_ABS), 0x00, 0x60})
+	rom[0x7FFC], rom[0x7FFD] = 0x00, 0x80
+
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.PowerOn(mem)
+	for range 4 {
+		if _, err := cpu.Step(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.AssertEqualsUint8(0x08, cpu.Accumulator, "Expected to read 0x08 from the register but got %v")
+	if io.reads != 2 {
+		t.Fatalf("Expected INC and LDA to read the register once each but got %d", io.reads)
+	}
+}
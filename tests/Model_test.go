@@ -0,0 +1,135 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestRicoh2A03IgnoresDecimalFlag(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.SED), c.Word(c.LDA_I), 0x09, c.Word(c.ADC_I), 0x01)
+	cpu := newStackTestCpu(t)
+	cpu.Model = c.Ricoh2A03
+
+	if err := cpu.Execute(6, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assert.AssertEqualsUint8(0x0A, cpu.Accumulator, "ADC has to add binary but got %v")
+	assert.AssertEqualsUint8(1, cpu.Status.GetDecimalFlag(), "SED still has to set the flag %v")
+}
+
+func TestMOS6507MasksAddresses(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	// The program runs at $F000 but only $1000 is wired up
+	mem := ut.NewProgramMemory(t, 0x1000, c.Word(c.LDA_ABS), 0x10, 0xF0, c.Word(c.STA_ABS), 0x20, 0xE0)
+	mem.WriteWord(0x1010, 0x42)
+	mem.WriteAddress(0x1FFC, 0xF000)
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.Model = c.MOS6507
+	cpu.PowerOn(mem)
+	assertProgramCounter(t, cpu, 0xF000)
+
+	if err := cpu.Execute(8, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assert.AssertEqualsUint8(0x42, cpu.Accumulator, "Expected the value from $1010 but got %v")
+	assert.AssertEqualsUint8(0x42, mem.ReadWord(0x0020), "Expected the value stored at $0020 but got %v")
+	assertProgramCounter(t, cpu, 0xF006)
+}
+
+func TestMOS6507HasNoInterruptLines(t *testing.T) {
+	mem := newInterruptTestMemory(t, c.Word(c.LDX_I), 0x01)
+	cpu := newStackTestCpu(t)
+	cpu.Model = c.MOS6507
+	cpu.AssertIRQ()
+	cpu.TriggerNMI()
+
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	assertProgramCounter(t, cpu, 0x0202)
+}
+
+func TestMOS6510IOPort(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200,
+		c.Word(c.LDA_I), 0x0F, c.Word(c.STA_Z), 0x00, // pins 0-3 are outputs
+		c.Word(c.LDA_I), 0x05, c.Word(c.STA_Z), 0x01,
+		c.Word(c.LDA_Z), 0x01,
+	)
+	mem.WriteWord(0x0001, 0xAA)
+	cpu := newStackTestCpu(t)
+	cpu.Model = c.MOS6510
+	cpu.Port.Input = 0x30
+	var changes []c.Word
+	cpu.Port.OnChange = func(output c.Word) {
+		changes = append(changes, output)
+	}
+
+	if err := cpu.Execute(13, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	assert.AssertEqualsUint8(0x0F, cpu.Port.Direction, "Wrong data direction %v")
+	assert.AssertEqualsUint8(0x35, cpu.Accumulator, "Expected the outputs and the inputs of the port but got %v")
+	assert.AssertEqualsUint8(0x05, mem.ReadWord(0x0001), "Writes have to reach the RAM underneath, got %v")
+	if len(changes) != 2 || changes[1] != 0x35 {
+		t.Fatalf("Expected the port to report its output after every write but got %v", changes)
+	}
+}
+
+func TestMOS6510PowerOnResetsIOPort(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDA_I), 0x07, c.Word(c.STA_Z), 0x00, c.Word(c.STA_Z), 0x01)
+	mem.WriteAddress(c.ResetVector, 0x0200)
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.Model = c.MOS6510
+	cpu.Port.Input = 0x30
+	cpu.PowerOn(mem)
+	if err := cpu.Execute(8, mem, true); err != nil {
+		t.Fatal(err)
+	}
+	var output c.Word
+	cpu.Port.OnChange = func(o c.Word) {
+		output = o
+	}
+
+	cpu.Reset(mem)
+	if cpu.Port.Direction != 0x00 || cpu.Port.Data != 0x07 || output != 0x30 {
+		t.Fatalf("Expected Reset to make all pins inputs but got direction %s data %s output %s",
+			cpu.Port.Direction, cpu.Port.Data, output)
+	}
+	cpu.PowerOn(mem)
+	if cpu.Port.Direction != 0x00 || cpu.Port.Data != 0x00 {
+		t.Fatalf("Expected PowerOn to clear the port but got direction %s data %s", cpu.Port.Direction, cpu.Port.Data)
+	}
+}
+
+func TestPeekAndWatchSeeAddressPinsOfModel(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x1000, c.Word(c.LDA_ABS), 0x10, 0xF0)
+	mem.WriteWord(0x1010, 0x42)
+	mem.WriteWord(0x0000, 0x99)
+	cpu := newStackTestCpu(t)
+	cpu.Model = c.MOS6507
+	cpu.ProgramCounter = 0x1000
+	var reads []c.Address
+	cpu.Watch(c.WatchRead, 0x1010, 0x1010, func(event c.WatchEvent) {
+		reads = append(reads, event.Address)
+	})
+
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	if len(reads) != 1 || reads[0] != 0x1010 {
+		t.Fatalf("Expected a watch on $1010 to see the read of $F010 at $1010 but got %v", reads)
+	}
+	if value := cpu.Peek(mem, 0xF010); value != 0x42 {
+		t.Fatalf("Expected to peek at $1010 through $F010 but got %s", value)
+	}
+
+	cpu.Model = c.MOS6510
+	cpu.Port.Direction = 0x0F
+	if value := cpu.Peek(mem, 0x0000); value != 0x0F {
+		t.Fatalf("Expected to peek at the data direction register of the port but got %s", value)
+	}
+}
@@ -0,0 +1,63 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+)
+
+func TestOpcodeTableCoversOfficialInstructionSet(t *testing.T) {
+	defined := 0
+	for i := 0; i < 256; i++ {
+		op := c.LookupOpcode(c.Instruction(i))
+		if !op.Defined() {
+			continue
+		}
+		defined++
+		if op.Cycles < 2 {
+			t.Errorf("%s: implausible cycle count %d", c.Instruction(i), op.Cycles)
+		}
+		if op.Bytes < 1 || op.Bytes > 3 {
+			t.Errorf("%s: implausible length %d", c.Instruction(i), op.Bytes)
+		}
+	}
+	if defined != 151 {
+		t.Fatalf("Expected 151 official opcodes but the table has %d", defined)
+	}
+}
+
+func TestOpcodeTableEntries(t *testing.T) {
+	data := []struct {
+		instruction c.Instruction
+		name        string
+		mnemonic    string
+		mode        c.AddressingMode
+		bytes       uint8
+		cycles      uint8
+	}{
+		{c.LDA_I, "LDA_I", "LDA", c.Immediate, 2, 2},
+		{c.LDA_Z, "LDA_Z", "LDA", c.ZeroPage, 2, 3},
+		{c.LDX_I, "LDX_I", "LDX", c.Immediate, 2, 2},
+		{c.ADC_ZX, "ADC_ZX", "ADC", c.ZeroPageX, 2, 4},
+		{c.JMP_ABS, "JMP_ABS", "JMP", c.Absolute, 3, 3},
+		{c.JMP_IND, "JMP_IND", "JMP", c.Indirect, 3, 5},
+		{c.STA_INDY, "STA_INDY", "STA", c.IndirectIndexed, 2, 6},
+		{c.ASL_A, "ASL_A", "ASL", c.Accumulator, 1, 2},
+		{c.BRK, "BRK", "BRK", c.Implied, 1, 7},
+		{c.BNE, "BNE", "BNE", c.Relative, 2, 2},
+	}
+	for _, d := range data {
+		op := c.LookupOpcode(d.instruction)
+		if d.instruction.String() != d.name {
+			t.Errorf("%#02x: expected name %s but got %s", uint8(d.instruction), d.name, d.instruction)
+		}
+		if op.Mnemonic != d.mnemonic || op.Mode != d.mode || op.Bytes != d.bytes || op.Cycles != d.cycles {
+			t.Errorf("%s: expected %s %s %d bytes %d cycles but got %s %s %d bytes %d cycles",
+				d.name, d.mnemonic, d.mode, d.bytes, d.cycles, op.Mnemonic, op.Mode, op.Bytes, op.Cycles)
+		}
+	}
+
+	if name := c.Instruction(0x02).String(); name != "JAM_02" {
+		t.Errorf("Undocumented opcodes should be printed with their name but got %s", name)
+	}
+}
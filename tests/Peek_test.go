@@ -0,0 +1,98 @@
+package tests_test
+
+import (
+	"strings"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// uart is an I/O Device whose data register is consumed by reading it.
+type uart struct {
+	received []c.Word
+}
+
+func (u *uart) Read(_ c.Address) c.Word {
+	if len(u.received) == 0 {
+		return 0
+	}
+	value := u.received[0]
+	u.received = u.received[1:]
+	return value
+}
+
+func (u *uart) Write(_ c.Address, _ c.Word) {}
+
+func (u *uart) Peek(_ c.Address) c.Word {
+	if len(u.received) == 0 {
+		return 0
+	}
+	return u.received[0]
+}
+
+func (u *uart) Poke(_ c.Address, value c.Word) {
+	u.received = append([]c.Word{value}, u.received...)
+}
+
+func TestPeekHasNoSideEffects(t *testing.T) {
+	serial := &uart{received: []c.Word{'H', 'i'}}
+	io := &register{value: 0x77}
+	rom := c.NewROM([]byte{0xEA, 0xEA})
+	mem := c.NewMemoryMap()
+	for _, err := range []error{
+		mem.Map(0x0000, 0x00FF, c.NewRAM(0x0100)),
+		mem.Map(0x5000, 0x5000, serial),
+		mem.Map(0x6000, 0x6000, io),
+		mem.Map(0xFFFE, 0xFFFF, rom),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	mem.WriteWord(0x0010, 0x42)
+
+	for range 2 {
+		if value := mem.Peek(0x5000); value != 'H' {
+			t.Fatalf("Expected to peek at 'H' but got %s", value)
+		}
+	}
+	if value := mem.Peek(0x6000); value != 0x42 || io.reads != 0 {
+		t.Fatalf("Expected a device without Peek to show the open bus but got %s after %d reads", value, io.reads)
+	}
+	if value := mem.ReadWord(0x5000); value != 'H' || mem.Peek(0x5000) != 'i' {
+		t.Fatalf("Expected the read to consume 'H' but got %s", value)
+	}
+
+	mem.Poke(0x0010, 0x43)
+	mem.Poke(0xFFFE, 0x00)
+	if mem.ReadWord(0x0010) != 0x43 || mem.ReadWord(0xFFFE) != 0x00 {
+		t.Fatalf("Expected Poke to change RAM and patch the ROM")
+	}
+}
+
+func TestPeekDoesNotCountCycles(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDA_I), 0x42)
+	cpu := newStackTestCpu(t)
+	reads := 0
+	cpu.Watch(c.WatchRead|c.WatchExecute, 0x0000, 0xFFFF, func(event c.WatchEvent) {
+		reads++
+	})
+
+	mem.Poke(0x0201, 0x43)
+	if mem.Peek(0x0201) != 0x43 || cpu.Cycle != 0 || reads != 0 {
+		t.Fatalf("Expected Peek and Poke to bypass the CPU")
+	}
+	if !strings.Contains(mem.String(), "[0X0201] 0X43") {
+		t.Fatalf("Expected the memory dump to show the poked value")
+	}
+}
+
+func TestTestMemoryPeekDoesNotConsume(t *testing.T) {
+	tm := ut.DefaultTestMemory(t)
+	tm.AppendInplace([]c.Word{0x01, 0x02})
+
+	if tm.Peek(0) != 0x01 || tm.Peek(0) != 0x01 || tm.ReadWord(0) != 0x01 || tm.Peek(0) != 0x02 {
+		t.Fatalf("Expected Peek to show the next value without consuming it")
+	}
+}
@@ -0,0 +1,93 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// newROMMachine maps 32K RAM and a 32K ROM with a program writing 0x42 to its own reset vector.
+func newROMMachine(t *testing.T, policy c.ROMWritePolicy) (*c.SixFiveOTwo, *c.MemoryMap, *c.ROM) {
+	t.Helper()
+	image := make([]byte, 0x8000)
+	copy(image, []byte{byte(c.LDA_I), 0x42, byte(c.STA_ABS), 0xFC, 0xFF, byte(c.LDX_I), 0x01})
+	image[0x7FFC], image[0x7FFD] = 0x00, 0x80
+	rom := c.NewROM(image)
+	rom.Policy = policy
+	mem := c.NewMemoryMap()
+	if err := mem.Map(0x0000, 0x7FFF, c.NewRAM(0x8000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := mem.Map(0x8000, 0xFFFF, rom); err != nil {
+		t.Fatal(err)
+	}
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.PowerOn(mem)
+	return cpu, mem, rom
+}
+
+func TestROMIgnoresWrites(t *testing.T) {
+	cpu, mem, rom := newROMMachine(t, c.IgnoreROMWrites)
+
+	if err := cpu.Execute(7, mem, false); err != nil {
+		t.Fatal(err)
+	}
+	if vector := mem.ReadAddress(c.ResetVector); vector != 0x8000 {
+		t.Fatalf("Expected the reset vector to stay 0x8000 but got %s", vector)
+	}
+	if rom.Size() != 0x8000 {
+		t.Fatalf("Expected a 32K ROM but got %d bytes", rom.Size())
+	}
+}
+
+func TestROMCallsBackOnWrite(t *testing.T) {
+	cpu, mem, rom := newROMMachine(t, c.CallbackOnROMWrite)
+	var writes []c.ROMWriteError
+	rom.OnWrite = func(err *c.ROMWriteError) {
+		writes = append(writes, *err)
+	}
+
+	if err := cpu.Execute(8, mem, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(writes) != 1 || writes[0].Offset != 0x7FFC || writes[0].Value != 0x42 {
+		t.Fatalf("Expected a single write of 0x42 to offset 0x7FFC but got %v", writes)
+	}
+	if cpu.RegisterX != 0x01 {
+		t.Fatalf("Expected execution to continue after the write")
+	}
+}
+
+func TestROMHaltsOnWrite(t *testing.T) {
+	cpu, mem, _ := newROMMachine(t, c.HaltOnROMWrite)
+
+	err := cpu.Execute(0, mem, false)
+	var write *c.ROMWriteError
+	if !errors.As(err, &write) || write.Offset != 0x7FFC {
+		t.Fatalf("Expected a ROMWriteError at offset 0x7FFC but got %v", err)
+	}
+	if cpu.ProgramCounter != 0x8005 || cpu.RegisterX != 0x00 {
+		t.Fatalf("Expected the CPU to halt after the STA\n%s", cpu.Short())
+	}
+	if mem.ReadAddress(c.ResetVector) != 0x8000 {
+		t.Fatalf("Expected the reset vector to stay 0x8000")
+	}
+}
+
+func TestROMWrapsOffsetsBeyondImage(t *testing.T) {
+	rom := c.NewROM([]byte{0x11, 0x22, 0x33, 0x44})
+
+	if value := rom.Read(0x0005); value != 0x22 {
+		t.Fatalf("Expected offset 0x0005 to wrap around to 0x22 but got %s", value)
+	}
+	rom.Poke(0xFFFF, 0x55)
+	if value := rom.Peek(0x0003); value != 0x55 {
+		t.Fatalf("Expected the poke to offset 0xFFFF to change offset 0x0003 but got %s", value)
+	}
+	var mapping *c.MappingError
+	if err := c.NewMemoryMap().Map(0x0000, 0x0004, rom); !errors.As(err, &mapping) {
+		t.Fatalf("Expected a MappingError for a region beyond the image but got %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+package tests_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestMirrorRepeatsDevice(t *testing.T) {
+	ram := c.NewRAM(0x0800)
+	mirror, err := c.NewMirror(ram, 0x0800)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mem := c.NewMemoryMap()
+	if err := mem.Map(0x0000, 0x1FFF, mirror); err != nil {
+		t.Fatal(err)
+	}
+
+	mem.WriteWord(0x0801, 0x42)
+	for _, address := range []c.Address{0x0001, 0x1001, 0x1801} {
+		if value := mem.ReadWord(address); value != 0x42 {
+			t.Fatalf("Expected 0x42 at %s but got %s", address, value)
+		}
+	}
+	if ram[0x0001] != 0x42 {
+		t.Fatalf("Expected the write to reach offset 0x0001 of the RAM")
+	}
+}
+
+func TestRegionsRejectInvalidConfiguration(t *testing.T) {
+	var device *c.DeviceError
+	if _, err := c.NewMirror(c.NewRAM(0x0800), 0); !errors.As(err, &device) {
+		t.Fatalf("Expected a DeviceError for a mirror of size 0 but got %v", err)
+	}
+	if _, err := c.NewMirror(c.NewRAM(0x0800), 0x1000); !errors.As(err, &device) {
+		t.Fatalf("Expected a DeviceError for a mirror larger than the device but got %v", err)
+	}
+	if _, err := c.NewBankSwitch(); !errors.As(err, &device) {
+		t.Fatalf("Expected a DeviceError for a bank switch without banks but got %v", err)
+	}
+}
+
+func TestMirrorPeeksOpenBusOfDevicesWithSideEffects(t *testing.T) {
+	io := &register{value: 0x42}
+	mirror, err := c.NewMirror(io, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mem := c.NewMemoryMap()
+	if err := mem.Map(0x6000, 0x60FF, mirror); err != nil {
+		t.Fatal(err)
+	}
+	mem.WriteWord(0x9000, 0x17)
+
+	if value := mem.Peek(0x6010); value != 0x17 || io.reads != 0 {
+		t.Fatalf("Expected the open bus 0x17 without reading the register but got %s after %d reads", value, io.reads)
+	}
+}
+
+// newBankSwitchMachine maps RAM, 4 ROM banks at $8000 - $BFFF whose first byte is the bank number,
+// the latch at $6000 and a ROM with the reset vector and the program at $C000.
+func newBankSwitchMachine(t *testing.T, program ...byte) (*c.SixFiveOTwo, *c.MemoryMap, *c.BankSwitch) {
+	t.Helper()
+	banks := make([]c.Device, 4)
+	for i := range banks {
+		image := make([]byte, 0x4000)
+		image[0] = byte(i) + 0xB0
+		banks[i] = c.NewROM(image)
+	}
+	bankSwitch, err := c.NewBankSwitch(banks...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	image := make([]byte, 0x4000)
+	copy(image, program)
+	image[0x3FFC], image[0x3FFD] = 0x00, 0xC0
+
+	mem := c.NewMemoryMap()
+	for _, err := range []error{
+		mem.Map(0x0000, 0x7FFF, c.NewRAM(0x8000)),
+		mem.Map(0x6000, 0x6000, bankSwitch.Latch()),
+		mem.Map(0x8000, 0xBFFF, bankSwitch),
+		mem.Map(0xC000, 0xFFFF, c.NewROM(image)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.PowerOn(mem)
+	return cpu, mem, bankSwitch
+}
+
+func TestBankSwitchSelectsBankThroughLatch(t *testing.T) {
+	cpu, mem, bankSwitch := newBankSwitchMachine(t,
+		byte(c.LDA_I), 0x02,
+		byte(c.STA_ABS), 0x00, 0x60,
+		byte(c.LDA_ABS), 0x00, 0x80,
+	)
+
+	for range 3 {
+		if _, err := cpu.Step(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if cpu.Accumulator != 0xB2 || bankSwitch.Bank() != 2 {
+		t.Fatalf("Expected bank 2 to be selected but read %s from bank %d", cpu.Accumulator, bankSwitch.Bank())
+	}
+	if !strings.Contains(mem.String(), "bank 2 of 4") {
+		t.Fatalf("Expected the memory map to show the selected bank but got\n%s", mem)
+	}
+	bankSwitch.SelectBank(5)
+	if bankSwitch.Bank() != 1 {
+		t.Fatalf("Expected the bank number to wrap around but got %d", bankSwitch.Bank())
+	}
+	bankSwitch.SelectBank(-1)
+	if bankSwitch.Bank() != 3 || mem.ReadWord(0x8000) != 0xB3 {
+		t.Fatalf("Expected bank -1 to select the last bank but got bank %d", bankSwitch.Bank())
+	}
+}
+
+func TestMemoryMapSnapshotRestoresBankAndRAM(t *testing.T) {
+	_, mem, bankSwitch := newBankSwitchMachine(t)
+	bankSwitch.SelectBank(3)
+	mem.WriteWord(0x0010, 0x11)
+
+	snapshot := mem.Snapshot()
+	bankSwitch.SelectBank(0)
+	mem.WriteWord(0x0010, 0x22)
+	if err := mem.Restore(snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	if bankSwitch.Bank() != 3 || mem.ReadWord(0x8000) != 0xB3 {
+		t.Fatalf("Expected bank 3 to be restored but bank %d is selected", bankSwitch.Bank())
+	}
+	if value := mem.ReadWord(0x0010); value != 0x11 {
+		t.Fatalf("Expected the RAM to be restored to 0x11 but got %s", value)
+	}
+	var snapshotErr *c.SnapshotError
+	if err := mem.Restore(c.NewRAM(1).Snapshot()); !errors.As(err, &snapshotErr) {
+		t.Fatalf("Expected a SnapshotError for a foreign snapshot but got %v", err)
+	}
+}
+
+// haltingROM returns a ROM with HaltOnROMWrite and a program writing 0x42 to its own reset vector, like
+// newROMMachine.
+func haltingROM() *c.ROM {
+	image := make([]byte, 0x8000)
+	copy(image, []byte{byte(c.LDA_I), 0x42, byte(c.STA_ABS), 0xFC, 0xFF, byte(c.LDX_I), 0x01})
+	image[0x7FFC], image[0x7FFD] = 0x00, 0x80
+	rom := c.NewROM(image)
+	rom.Policy = c.HaltOnROMWrite
+	return rom
+}
+
+func TestRegionsReportFaultOfROM(t *testing.T) {
+	mirror, err := c.NewMirror(haltingROM(), 0x8000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bankSwitch, err := c.NewBankSwitch(haltingROM(), c.NewRAM(0x8000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, region := range []c.Device{mirror, bankSwitch} {
+		mem := c.NewMemoryMap()
+		if err := mem.Map(0x0000, 0x7FFF, c.NewRAM(0x8000)); err != nil {
+			t.Fatal(err)
+		}
+		if err := mem.Map(0x8000, 0xFFFF, region); err != nil {
+			t.Fatal(err)
+		}
+		cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+		cpu.PowerOn(mem)
+
+		err := cpu.Execute(0, mem, false)
+		var write *c.ROMWriteError
+		if !errors.As(err, &write) || write.Offset != 0x7FFC {
+			t.Fatalf("Expected a ROMWriteError at offset 0x7FFC behind %T but got %v", region, err)
+		}
+		if cpu.ProgramCounter != 0x8005 || cpu.RegisterX != 0x00 {
+			t.Fatalf("Expected the CPU to halt after the STA behind %T\n%s", region, cpu.Short())
+		}
+	}
+
+	// a bank may fault before another one is selected
+	bankSwitch.Write(0x0000, 0x42)
+	bankSwitch.SelectBank(1)
+	if err := bankSwitch.Fault(); err == nil {
+		t.Fatalf("Expected the fault of the deselected bank to be reported")
+	}
+	if err := bankSwitch.Fault(); err != nil {
+		t.Fatalf("Expected the fault to be cleared but got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package tests_test
+
+import (
+	"strconv"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestCompareTransferAndFlags(t *testing.T) {
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	tm := ut.DefaultTestMemory(t)
+
+	data := []ut.InstructionTestData{
+		{Name: "CMP equal", AccumolatorSetup: 0x10, MemorySetup: []c.Word{c.Word(c.CMP_I), 0x10}, ExpectToAdvancedCycles: 2, ExpectAccumulatorValue: 0x10, ExpectedProcessorStatusValue: 0b00000011},
+		{Name: "CMP greater", AccumolatorSetup: 0x10, MemorySetup: []c.Word{c.Word(c.CMP_Z), 0x20, 0x01}, ExpectToAdvancedCycles: 3, ExpectAccumulatorValue: 0x10, ExpectedProcessorStatusValue: 0b00000001},
+		{Name: "CMP less", AccumolatorSetup: 0x10, MemorySetup: []c.Word{c.Word(c.CMP_I), 0x11}, ExpectToAdvancedCycles: 2, ExpectAccumulatorValue: 0x10, ExpectedProcessorStatusValue: 0b10000000},
+		{Name: "CPX", RegisterXSetup: 0x80, MemorySetup: []c.Word{c.Word(c.CPX_I), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b10000001},
+		{Name: "CPY", RegisterYSetup: 0x05, MemorySetup: []c.Word{c.Word(c.CPY_ABS), 0x00, 0x20, 0x05}, ExpectToAdvancedCycles: 4, ExpectedProcessorStatusValue: 0b00000011},
+		{Name: "TXA", RegisterXSetup: 0x80, MemorySetup: []c.Word{c.Word(c.TXA), 0x00}, ExpectToAdvancedCycles: 2, ExpectAccumulatorValue: 0x80, ExpectedProcessorStatusValue: 0b10000000},
+		{Name: "TYA", AccumolatorSetup: 0x01, MemorySetup: []c.Word{c.Word(c.TYA), 0x00}, ExpectToAdvancedCycles: 2, ExpectAccumulatorValue: 0x00, ExpectedProcessorStatusValue: 0b00000010},
+		{Name: "SEC", MemorySetup: []c.Word{c.Word(c.SEC), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b00000001},
+		{Name: "CLC", ProcessorStatusSetup: 0b11111111, MemorySetup: []c.Word{c.Word(c.CLC), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b11111110},
+		{Name: "SEI", MemorySetup: []c.Word{c.Word(c.SEI), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b00000100},
+		{Name: "CLI", ProcessorStatusSetup: 0b11111111, MemorySetup: []c.Word{c.Word(c.CLI), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b11111011},
+		{Name: "SED", MemorySetup: []c.Word{c.Word(c.SED), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b00001000},
+		{Name: "CLD", ProcessorStatusSetup: 0b11111111, MemorySetup: []c.Word{c.Word(c.CLD), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b11110111},
+		{Name: "CLV", ProcessorStatusSetup: 0b11111111, MemorySetup: []c.Word{c.Word(c.CLV), 0x00}, ExpectToAdvancedCycles: 2, ExpectedProcessorStatusValue: 0b10111111},
+		{Name: "NOP", AccumolatorSetup: 0x42, ProcessorStatusSetup: 0b10000001, MemorySetup: []c.Word{c.Word(c.NOP), 0x00}, ExpectToAdvancedCycles: 2, ExpectAccumulatorValue: 0x42, ExpectedProcessorStatusValue: 0b10000001},
+	}
+
+	for idx, testData := range data {
+		testData.Name = strconv.Itoa(idx+1) + "_" + testData.Name
+		testData.Run(t, cpu, tm)
+	}
+}
+
+func TestIndexRegisters(t *testing.T) {
+	data := []struct {
+		name        string
+		instruction c.Instruction
+		a, x, y     c.Word
+		expectX     c.Word
+		expectY     c.Word
+		status      uint8
+	}{
+		{"INX", c.INX, 0, 0x7F, 0, 0x80, 0, 0b10000000},
+		{"INX wraps", c.INX, 0, 0xFF, 0, 0x00, 0, 0b00000010},
+		{"INY", c.INY, 0, 0, 0x01, 0, 0x02, 0b00000000},
+		{"DEX", c.DEX, 0, 0x01, 0, 0x00, 0, 0b00000010},
+		{"DEY wraps", c.DEY, 0, 0, 0x00, 0, 0xFF, 0b10000000},
+		{"TAX", c.TAX, 0x81, 0, 0, 0x81, 0, 0b10000000},
+		{"TAY", c.TAY, 0x00, 0, 0x01, 0, 0x00, 0b00000010},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			assert := ut.AssertHelperNew(t)
+			mem := ut.NewProgramMemory(t, 0x0200, c.Word(d.instruction))
+			cpu := newStackTestCpu(t)
+			cpu.Accumulator, cpu.RegisterX, cpu.RegisterY = d.a, d.x, d.y
+
+			cpu.Execute(1, mem, true)
+			assertCycles(t, cpu, 2)
+			assert.AssertEqualsUint8(uint8(d.expectX), cpu.RegisterX, "Wrong X-Register %v")
+			assert.AssertEqualsUint8(uint8(d.expectY), cpu.RegisterY, "Wrong Y-Register %v")
+			assert.AssertEqualsUint8(d.status, cpu.Status, "Wrong Status register \n%v")
+		})
+	}
+}
+
+func TestIncrementAndDecrementMemory(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200,
+		c.Word(c.INC_Z), 0x10,
+		c.Word(c.DEC_ABS), 0x00, 0x20,
+		c.Word(c.INC_ABSX), 0xFF, 0x20,
+	)
+	mem.WriteWord(0x10, 0xFF)
+	mem.WriteWord(0x2000, 0x01)
+	mem.WriteWord(0x2100, 0x7F)
+	cpu := newStackTestCpu(t)
+	cpu.RegisterX = 0x01
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 5)
+	assert.AssertEqualsUint8(0x00, mem.ReadWord(0x10), "Wrong value after INC %v")
+	assert.AssertEqualsUint8(0b00000010, cpu.Status, "Wrong Status register \n%v")
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 11)
+	assert.AssertEqualsUint8(0x00, mem.ReadWord(0x2000), "Wrong value after DEC %v")
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 18)
+	assert.AssertEqualsUint8(0x80, mem.ReadWord(0x2100), "Wrong value after INC %v")
+	assert.AssertEqualsUint8(0b10000000, cpu.Status, "Wrong Status register \n%v")
+}
+
+func TestLoopProgram(t *testing.T) {
+	// Multiplies 7 by 5 through repeated addition
+	mem := ut.NewProgramMemory(t, 0x0200,
+		c.Word(c.LDX_I), 0x05, // 0x0200
+		c.Word(c.LDA_I), 0x00, // 0x0202
+		c.Word(c.CLC),         // 0x0204 loop:
+		c.Word(c.ADC_I), 0x07, // 0x0205
+		c.Word(c.DEX),       // 0x0207
+		c.Word(c.BNE), 0xFA, // 0x0208 BNE loop
+		c.Word(c.STA_Z), 0x10, // 0x020A
+		c.Word(c.NOP), // 0x020C
+	)
+	cpu := newStackTestCpu(t)
+
+	err := cpu.RunUntil(mem, func(cpu *c.SixFiveOTwo) bool { return cpu.ProgramCounter == 0x020C })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := mem.ReadWord(0x10); result != 35 {
+		t.Fatalf("Expected 35 but got %d", result)
+	}
+	// 2 + 2 + 5 * (2 + 2 + 2 + 3) - 1 + 3
+	assertCycles(t, cpu, 51)
+}
@@ -0,0 +1,56 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestPowerOn(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0300, c.Word(c.LDA_I), 0x42)
+	mem.WriteAddress(c.ResetVector, 0x0300)
+	mem.WriteWord(0x0010, 0x99)
+
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.Accumulator = 0x11
+	cpu.PowerOn(mem)
+
+	assertCycles(t, cpu, 7)
+	assertProgramCounter(t, cpu, 0x0300)
+	assert.AssertEqualsUint8(0xFD, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0x00, cpu.Accumulator, "Wrong Accumulator %v")
+	assert.AssertEqualsUint8(0b00000100, cpu.Status, "Reset has to set the interrupt disable flag \n%v")
+	assert.AssertEqualsUint8(0x99, mem.ReadWord(0x0010), "Reset must not touch memory but found %v")
+
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0x42, cpu.Accumulator, "Wrong Accumulator %v")
+}
+
+func TestWarmReset(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0300, c.Word(c.LDA_I), 0x42)
+	mem.WriteAddress(c.ResetVector, 0x0300)
+
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.Cycle = 100
+	cpu.ProgramCounter = 0x1234
+	cpu.StackPointer = 0xF0
+	cpu.Accumulator = 0x11
+	cpu.Status.Status = 0b11000001
+	cpu.TriggerNMI()
+
+	cpu.Reset(mem)
+
+	assertCycles(t, cpu, 107)
+	assertProgramCounter(t, cpu, 0x0300)
+	assert.AssertEqualsUint8(0xED, cpu.StackPointer, "Reset has to decrement the StackPointer by three but got %v")
+	assert.AssertEqualsUint8(0x11, cpu.Accumulator, "Reset must keep the Accumulator but got %v")
+	assert.AssertEqualsUint8(0b11000101, cpu.Status, "Reset has to set the interrupt disable flag only \n%v")
+	assert.AssertEqualsUint8(0x00, mem.ReadWord(0x01F0), "Reset must not write to the stack but found %v")
+
+	// A pending NMI is dropped by the reset
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0x42, cpu.Accumulator, "Wrong Accumulator %v")
+}
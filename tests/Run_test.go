@@ -0,0 +1,106 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestStep(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDA_I), 0x01, c.Word(c.LDA_ABSX), 0xFF, 0x20, c.Word(c.PHA))
+	cpu := newStackTestCpu(t)
+	cpu.RegisterX = 0x01
+
+	for _, expected := range []uint{2, 5, 3} {
+		used, err := cpu.Step(mem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if used != expected {
+			t.Fatalf("Expected the step to take %d cycles but took %d", expected, used)
+		}
+	}
+	assertCycles(t, cpu, 10)
+}
+
+func TestRunCyclesCarriesOverCycleDebt(t *testing.T) {
+	program := make([]c.Word, 0, 20)
+	for i := 0; i < 10; i++ {
+		program = append(program, c.Word(c.LDA_I), c.Word(i))
+	}
+	mem := ut.NewProgramMemory(t, 0x0200, program...)
+	cpu := newStackTestCpu(t)
+
+	// Every instruction takes 2 cycles, the first call runs one cycle past its budget
+	if err := cpu.RunCycles(3, mem); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 4)
+	if err := cpu.RunCycles(3, mem); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 6)
+	if err := cpu.RunCycles(1, mem); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 8)
+	// The debt of one cycle uses up the whole budget
+	if err := cpu.RunCycles(1, mem); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 8)
+	if err := cpu.RunCycles(12, mem); err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 20)
+}
+
+func TestRunUntil(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDX_I), 0x01, c.Word(c.JMP_ABS), 0x00, 0x30)
+	mem.WriteWord(0x3000, c.Word(c.LDX_I))
+	mem.WriteWord(0x3001, 0x02)
+	cpu := newStackTestCpu(t)
+
+	err := cpu.RunUntil(mem, func(cpu *c.SixFiveOTwo) bool {
+		return cpu.ProgramCounter == 0x3000
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCycles(t, cpu, 5)
+	if cpu.RegisterX != 0x01 {
+		t.Fatalf("RunUntil has to stop before the instruction at the target")
+	}
+}
+
+// cancelAfter cancels the context after the given number of reads.
+type cancelAfter struct {
+	*c.Memory16K
+	reads  int
+	cancel context.CancelFunc
+}
+
+func (mem *cancelAfter) ReadWord(source c.Address) c.Word {
+	mem.reads--
+	if mem.reads == 0 {
+		mem.cancel()
+	}
+	return mem.Memory16K.ReadWord(source)
+}
+
+func TestRunContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// JMP $0200 forever, every JMP reads three bytes
+	mem := &cancelAfter{Memory16K: ut.NewProgramMemory(t, 0x0200, c.Word(c.JMP_ABS), 0x00, 0x02), reads: 30, cancel: cancel}
+	cpu := newStackTestCpu(t)
+
+	err := cpu.RunContext(ctx, mem)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled but got %v", err)
+	}
+	assertCycles(t, cpu, 30)
+}
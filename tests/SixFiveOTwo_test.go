@@ -123,25 +123,35 @@ func TestStatusRegister(t *testing.T) {
 }
 
 func TestMiniProgramm(t *testing.T) {
-	gt = t
-	logger := c.SetupLogging()
-
-	cpu := c.NewSixFiveOTwo(&logger)
+	cpu := c.NewSixFiveOTwo(tu.NewTestCpuLogger(t))
 
 	mem := c.Memory16K{}
 	_ = mem.Init()
 
-	cpu.Reset(&mem)
-
-	_ = programs.MiniProg.CopyToMemory(cpu.ProgramCounter, &mem)
-
-	cpu.Execute(1, &mem, true)
-	cpu.AssertCycle(2)
-	cpu.Execute(1, &mem, true)
-	cpu.AssertCycle(4)
-	cpu.Execute(1, &mem, true)
-	cpu.AssertCycle(8)
+	_ = programs.MiniProg.CopyToMemory(0x0200, &mem)
+
+	cpu.PowerOn(&mem)
+
+	// LDA_Z
+	if err := cpu.Execute(1, &mem, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := cpu.AssertCycle(10); err != nil {
+		t.Fatal(err)
+	}
+	// LDX_I
+	if err := cpu.Execute(1, &mem, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := cpu.AssertCycle(12); err != nil {
+		t.Fatal(err)
+	}
+	// ADC_ZX
+	if err := cpu.Execute(1, &mem, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := cpu.AssertCycle(16); err != nil {
+		t.Fatal(err)
+	}
 	t.Log(cpu)
-
-	_ = logger.Close()
 }
@@ -0,0 +1,151 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func newStackTestCpu(t *testing.T) *c.SixFiveOTwo {
+	cpu := c.NewSixFiveOTwo(ut.NewTestCpuLogger(t))
+	cpu.ProgramCounter = 0x0200
+	cpu.StackPointer = 0xFF
+	return cpu
+}
+
+func assertCycles(t *testing.T, cpu *c.SixFiveOTwo, expected uint) {
+	t.Helper()
+	if cpu.Cycle != expected {
+		t.Fatalf("Expected %d cycles but took %d", expected, cpu.Cycle)
+	}
+}
+
+func TestPushAndPullAccumulator(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.PHA), c.Word(c.LDA_I), 0x00, c.Word(c.PLA))
+	cpu := newStackTestCpu(t)
+	cpu.Accumulator = 0x80
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 3)
+	assert.AssertEqualsUint8(0xFE, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0x80, mem.ReadWord(0x01FF), "Wrong value on the stack %v")
+
+	cpu.Execute(1, mem, true)
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 9)
+	assert.AssertEqualsUint8(0xFF, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0x80, cpu.Accumulator, "Wrong Accumulator %v")
+	assert.AssertEqualsUint8(0b10000000, cpu.Status, "Wrong Status register \n%v")
+}
+
+func TestPushAndPullStatus(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.PHP), c.Word(c.PLP))
+	cpu := newStackTestCpu(t)
+	cpu.Status.Status = 0b11000011
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 3)
+	assert.AssertEqualsUint8(0b11110011, mem.ReadWord(0x01FF), "PHP has to set B and the unused bit but pushed %v")
+
+	cpu.Status.Reset()
+	mem.WriteWord(0x01FF, 0b00111100)
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 7)
+	assert.AssertEqualsUint8(0b00001100, cpu.Status, "PLP has to ignore B and the unused bit \n%v")
+}
+
+func TestStackWrapsInsideStackPage(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.PHA), c.Word(c.PLA))
+	cpu := newStackTestCpu(t)
+	cpu.StackPointer = 0x00
+	cpu.Accumulator = 0x42
+
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0xFF, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0x42, mem.ReadWord(0x0100), "Wrong value on the stack %v")
+	assert.AssertEqualsUint8(0x00, mem.ReadWord(0x0200-1), "Push must not leave the stack page %v")
+
+	cpu.Accumulator = 0
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0x00, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0x42, cpu.Accumulator, "Wrong Accumulator %v")
+}
+
+func TestJumpToSubroutineAndReturn(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.JSR_ABS), 0x00, 0x30, c.Word(c.LDX_I), 0x01)
+	mem.WriteWord(0x3000, c.Word(c.RTS))
+	cpu := newStackTestCpu(t)
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 6)
+	if cpu.ProgramCounter != 0x3000 {
+		t.Fatalf("Expected PC 0x3000 but got %v", cpu.ProgramCounter)
+	}
+	assert.AssertEqualsUint8(0xFD, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0x02, mem.ReadWord(0x01FF), "Wrong high byte of the return address %v")
+	assert.AssertEqualsUint8(0x02, mem.ReadWord(0x01FE), "Wrong low byte of the return address %v")
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 12)
+	if cpu.ProgramCounter != 0x0203 {
+		t.Fatalf("Expected PC 0x0203 but got %v", cpu.ProgramCounter)
+	}
+	assert.AssertEqualsUint8(0xFF, cpu.StackPointer, "Wrong StackPointer %v")
+
+	cpu.Execute(1, mem, true)
+	assert.AssertEqualsUint8(0x01, cpu.RegisterX, "Execution did not continue after the JSR %v")
+}
+
+func TestJumpToSubroutineFetchesTargetAroundPushes(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.JSR_ABS), 0x00, 0x30)
+	cpu := newStackTestCpu(t)
+	var accesses []c.WatchEvent
+	cpu.Watch(c.WatchRead|c.WatchWrite, 0x0000, 0xFFFF, func(event c.WatchEvent) {
+		accesses = append(accesses, event)
+	})
+
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		kind    c.WatchKind
+		address c.Address
+	}{
+		{c.WatchRead, 0x0201},
+		{c.WatchRead, 0x01FF},
+		{c.WatchWrite, 0x01FF},
+		{c.WatchWrite, 0x01FE},
+		{c.WatchRead, 0x0202},
+	}
+	if len(accesses) != len(expected) {
+		t.Fatalf("Expected %d accesses but got %v", len(expected), accesses)
+	}
+	for i, e := range expected {
+		if accesses[i].Kind != e.kind || accesses[i].Address != e.address {
+			t.Errorf("Expected access %d to be a %s of %s but got %s", i, e.kind, e.address, accesses[i])
+		}
+	}
+}
+
+func TestTransferStackPointer(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.TSX), c.Word(c.TXS))
+	cpu := newStackTestCpu(t)
+	cpu.StackPointer = 0x80
+
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 2)
+	assert.AssertEqualsUint8(0x80, cpu.RegisterX, "Wrong X-Register %v")
+	assert.AssertEqualsUint8(0b10000000, cpu.Status, "TSX has to set the negative flag \n%v")
+
+	cpu.RegisterX = 0x00
+	cpu.Execute(1, mem, true)
+	assertCycles(t, cpu, 4)
+	assert.AssertEqualsUint8(0x00, cpu.StackPointer, "Wrong StackPointer %v")
+	assert.AssertEqualsUint8(0b10000000, cpu.Status, "TXS must not change flags \n%v")
+}
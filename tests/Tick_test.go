@@ -0,0 +1,182 @@
+package tests_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// countingMemory counts every access of the CPU to the bus.
+type countingMemory struct {
+	*c.Memory16K
+	accesses uint
+}
+
+func (mem *countingMemory) ReadWord(source c.Address) c.Word {
+	mem.accesses++
+	return mem.Memory16K.ReadWord(source)
+}
+
+func (mem *countingMemory) WriteWord(destination c.Address, value c.Word) {
+	mem.accesses++
+	mem.Memory16K.WriteWord(destination, value)
+}
+
+// tickProgram touches the stack, a read-modify-write, a page crossing and a taken branch.
+var tickProgram = []c.Word{
+	c.Word(c.LDA_I), 0x41,
+	c.Word(c.STA_Z), 0x10,
+	c.Word(c.INC_Z), 0x10,
+	c.Word(c.LDX_I), 0x20,
+	c.Word(c.LDA_ABSX), 0xF0, 0x00,
+	c.Word(c.PHA),
+	c.Word(c.PLA),
+	c.Word(c.JSR_ABS), 0x20, 0x02,
+	c.Word(c.BNE), 0xFE,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	c.Word(c.RTS),
+}
+
+func TestEveryTickIsOneBusAccess(t *testing.T) {
+	mem := &countingMemory{Memory16K: ut.NewProgramMemory(t, 0x0200, tickProgram...)}
+	cpu := newStackTestCpu(t)
+
+	for range 60 {
+		cycle, accesses := cpu.Cycle, mem.accesses
+		if err := cpu.Tick(mem); err != nil {
+			t.Fatal(err)
+		}
+		if cpu.Cycle != cycle+1 || mem.accesses != accesses+1 {
+			t.Fatalf("Expected one cycle and one access but got %d cycles and %d accesses at %s",
+				cpu.Cycle-cycle, mem.accesses-accesses, cpu.ProgramCounter)
+		}
+	}
+}
+
+func TestTickMatchesStep(t *testing.T) {
+	stepMem := ut.NewProgramMemory(t, 0x0200, tickProgram...)
+	stepped := newStackTestCpu(t)
+	tickMem := ut.NewProgramMemory(t, 0x0200, tickProgram...)
+	ticked := newStackTestCpu(t)
+
+	for range 12 {
+		if _, err := stepped.Step(stepMem); err != nil {
+			t.Fatal(err)
+		}
+		for ticked.Cycle < stepped.Cycle {
+			if err := ticked.Tick(tickMem); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if ticked.ProgramCounter != stepped.ProgramCounter || ticked.Accumulator != stepped.Accumulator ||
+			ticked.StackPointer != stepped.StackPointer || ticked.Status != stepped.Status {
+			t.Fatalf("Tick and Step differ after %d cycles:\n%s\n%s", stepped.Cycle, ticked.Short(), stepped.Short())
+		}
+	}
+	if tickMem.ReadWord(0x0010) != 0x42 {
+		t.Fatalf("Expected INC to write 0x42 but got %s", tickMem.ReadWord(0x0010))
+	}
+}
+
+func TestStepFinishesTheInstructionOfTick(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.INC_ABS), 0x00, 0x30, c.Word(c.LDA_I), 0x01)
+	cpu := newStackTestCpu(t)
+
+	for range 2 {
+		if err := cpu.Tick(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cycles, err := cpu.Step(mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cycles != 2 || cpu.Accumulator != 0x01 {
+		t.Fatalf("Expected Step to run LDA in 2 cycles but took %d\n%s", cycles, cpu.Short())
+	}
+	assertCycles(t, cpu, 8)
+	if mem.ReadWord(0x3000) != 0x01 {
+		t.Fatalf("Expected INC to be finished but memory is %s", mem.ReadWord(0x3000))
+	}
+}
+
+func TestTickReportsJamWithoutCycle(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.JAM_02))
+	cpu := newStackTestCpu(t)
+	cpu.UndocumentedOpcodes = true
+
+	var err error
+	for range 10 {
+		if err = cpu.Tick(mem); err != nil {
+			break
+		}
+	}
+	var jam *c.JamError
+	if !errors.As(err, &jam) {
+		t.Fatalf("Expected a JamError but got %v", err)
+	}
+	cycle := cpu.Cycle
+	if err := cpu.Tick(mem); !errors.As(err, &jam) || cpu.Cycle != cycle {
+		t.Fatalf("Expected a halted CPU to return the JamError without a cycle but got %v", err)
+	}
+}
+
+func TestStopReleasesTickInMiddleOfInstruction(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.INC_ABS), 0x00, 0x30)
+	cpu := newStackTestCpu(t)
+	goroutines := runtime.NumGoroutine()
+
+	for range 3 {
+		if err := cpu.Tick(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if runtime.NumGoroutine() <= goroutines {
+		t.Fatalf("Expected Tick to run the instruction in a coroutine")
+	}
+	cpu.Stop()
+	if n := runtime.NumGoroutine(); n != goroutines {
+		t.Fatalf("Expected Stop to release the coroutine but %d goroutines are left of %d", n, goroutines)
+	}
+	assertCycles(t, cpu, 6)
+	if mem.ReadWord(0x3000) != 0x01 {
+		t.Fatalf("Expected Stop to finish INC but memory is %s", mem.ReadWord(0x3000))
+	}
+	cpu.Stop()
+}
+
+// TestBusAccessesMatchCycles runs every documented opcode of both models and checks that
+// every cycle is spent on an access to the bus.
+func TestBusAccessesMatchCycles(t *testing.T) {
+	for _, model := range []c.Model{c.MOS6502, c.WDC65C02} {
+		for i := range 256 {
+			instruction := c.Instruction(i)
+			op := model.LookupOpcode(instruction)
+			if !op.Defined() || op.Mnemonic == "STP" || op.Mnemonic == "WAI" {
+				continue
+			}
+			for _, s := range timingSetups {
+				t.Run(model.String()+" "+op.Mnemonic+" "+op.Mode.String()+" "+s.name, func(t *testing.T) {
+					program := ut.NewProgramMemory(t, 0x0200, append([]c.Word{c.Word(instruction)}, s.operand...)...)
+					program.WriteAddress(c.Address(s.operand[0]), s.pointer)
+					mem := &countingMemory{Memory16K: program}
+					cpu := newStackTestCpu(t)
+					cpu.Model = model
+					cpu.RegisterX = 0x20
+					cpu.RegisterY = 0x20
+
+					cycles, err := cpu.Step(mem)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if mem.accesses != cycles {
+						t.Errorf("Expected %d accesses but got %d", cycles, mem.accesses)
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// publishedCycles are the base cycle counts of the official NMOS 6502 opcodes as published
+// in the MOS data sheets, 0 marks opcodes that are not part of the documented instruction set.
+var publishedCycles = [256]uint{
+	7, 6, 0, 0, 0, 3, 5, 0, 3, 2, 2, 0, 0, 4, 6, 0, // 0x00
+	2, 5, 0, 0, 0, 4, 6, 0, 2, 4, 0, 0, 0, 4, 7, 0, // 0x10
+	6, 6, 0, 0, 3, 3, 5, 0, 4, 2, 2, 0, 4, 4, 6, 0, // 0x20
+	2, 5, 0, 0, 0, 4, 6, 0, 2, 4, 0, 0, 0, 4, 7, 0, // 0x30
+	6, 6, 0, 0, 0, 3, 5, 0, 3, 2, 2, 0, 3, 4, 6, 0, // 0x40
+	2, 5, 0, 0, 0, 4, 6, 0, 2, 4, 0, 0, 0, 4, 7, 0, // 0x50
+	6, 6, 0, 0, 0, 3, 5, 0, 4, 2, 2, 0, 5, 4, 6, 0, // 0x60
+	2, 5, 0, 0, 0, 4, 6, 0, 2, 4, 0, 0, 0, 4, 7, 0, // 0x70
+	0, 6, 0, 0, 3, 3, 3, 0, 2, 0, 2, 0, 4, 4, 4, 0, // 0x80
+	2, 6, 0, 0, 4, 4, 4, 0, 2, 5, 2, 0, 0, 5, 0, 0, // 0x90
+	2, 6, 2, 0, 3, 3, 3, 0, 2, 2, 2, 0, 4, 4, 4, 0, // 0xA0
+	2, 5, 0, 0, 4, 4, 4, 0, 2, 4, 2, 0, 4, 4, 4, 0, // 0xB0
+	2, 6, 0, 0, 3, 3, 5, 0, 2, 2, 2, 0, 4, 4, 6, 0, // 0xC0
+	2, 5, 0, 0, 0, 4, 6, 0, 2, 4, 0, 0, 0, 4, 7, 0, // 0xD0
+	2, 6, 0, 0, 3, 3, 5, 0, 2, 2, 2, 0, 4, 4, 6, 0, // 0xE0
+	2, 5, 0, 0, 0, 4, 6, 0, 2, 4, 0, 0, 0, 4, 7, 0, // 0xF0
+}
+
+// publishedPageCross are the opcodes the data sheets mark with "+1 if page boundary is crossed".
+// Branches are left out, their timing is covered by TestBranch.
+var publishedPageCross = map[c.Instruction]bool{
+	c.ADC_ABSX: true, c.ADC_ABSY: true, c.ADC_INDY: true,
+	c.AND_ABSX: true, c.AND_ABSY: true, c.AND_INDY: true,
+	c.CMP_ABSX: true, c.CMP_ABSY: true, c.CMP_INDY: true,
+	c.EOR_ABSX: true, c.EOR_ABSY: true, c.EOR_INDY: true,
+	c.LDA_ABSX: true, c.LDA_ABSY: true, c.LDA_INDY: true,
+	c.LDX_ABSY: true, c.LDY_ABSX: true,
+	c.ORA_ABSX: true, c.ORA_ABSY: true, c.ORA_INDY: true,
+	c.SBC_ABSX: true, c.SBC_ABSY: true, c.SBC_INDY: true,
+}
+
+func TestOpcodeTableMatchesPublishedCycles(t *testing.T) {
+	for i := range 256 {
+		op := c.LookupOpcode(c.Instruction(i))
+		if !op.Defined() {
+			if publishedCycles[i] != 0 {
+				t.Errorf("%s is missing from the opcode table", c.Instruction(i))
+			}
+			continue
+		}
+		if uint(op.Cycles) != publishedCycles[i] {
+			t.Errorf("%s: table has %d cycles, published are %d", c.Instruction(i), op.Cycles, publishedCycles[i])
+		}
+	}
+}
+
+// timingSetups place the operand of an instruction once inside its page and once across a page.
+var timingSetups = []struct {
+	name    string
+	crossed bool
+	// operand are the bytes following the opcode
+	operand []c.Word
+	// pointer is stored at the zero page address of the operand for the indirect modes
+	pointer c.Address
+}{
+	{"same page", false, []c.Word{0x10, 0x20}, 0x2000},
+	{"page crossed", true, []c.Word{0xF0, 0x20}, 0x20F0},
+}
+
+// measureCycles executes a single instruction with X and Y set to $20 and returns the cycles it took.
+func measureCycles(t *testing.T, instruction c.Instruction, operand []c.Word, pointer c.Address, undocumented bool) uint {
+	t.Helper()
+	mem := ut.NewProgramMemory(t, 0x0200, append([]c.Word{c.Word(instruction)}, operand...)...)
+	mem.WriteAddress(c.Address(operand[0]), pointer)
+	cpu := newStackTestCpu(t)
+	cpu.UndocumentedOpcodes = undocumented
+	cpu.RegisterX = 0x20
+	cpu.RegisterY = 0x20
+
+	cycles, err := cpu.Step(mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cycles
+}
+
+// TestMeasuredCyclesMatchPublishedCycles executes every official opcode once with an operand
+// that stays inside its page and once with an operand that crosses a page.
+func TestMeasuredCyclesMatchPublishedCycles(t *testing.T) {
+	for i := range 256 {
+		instruction := c.Instruction(i)
+		op := c.LookupOpcode(instruction)
+		if !op.Defined() || op.Mode == c.Relative {
+			continue
+		}
+		for _, s := range timingSetups {
+			t.Run(instruction.String()+" "+s.name, func(t *testing.T) {
+				expected := publishedCycles[i]
+				if s.crossed && publishedPageCross[instruction] {
+					expected++
+				}
+				if cycles := measureCycles(t, instruction, s.operand, s.pointer, false); cycles != expected {
+					t.Errorf("Expected %d cycles but took %d", expected, cycles)
+				}
+			})
+		}
+	}
+}
+
+func TestZeroPageIndexedWrapsWithoutPenalty(t *testing.T) {
+	data := []struct {
+		name    string
+		program []c.Word
+		x, y    c.Word
+		address c.Address
+	}{
+		{"LDA_ZX", []c.Word{c.Word(c.LDA_ZX), 0xF0}, 0x20, 0x00, 0x0010},
+		{"LDX_ZY", []c.Word{c.Word(c.LDX_ZY), 0xFF}, 0x00, 0x02, 0x0001},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			mem := ut.NewProgramMemory(t, 0x0200, d.program...)
+			mem.WriteWord(d.address, 0x42)
+			mem.WriteWord(d.address+0x0100, 0x99)
+			cpu := newStackTestCpu(t)
+			cpu.RegisterX = d.x
+			cpu.RegisterY = d.y
+
+			if _, err := cpu.Step(mem); err != nil {
+				t.Fatal(err)
+			}
+			assertCycles(t, cpu, 4)
+			if cpu.Accumulator != 0x42 && cpu.RegisterX != 0x42 {
+				t.Fatalf("Expected 0x42 to be loaded from %s", d.address)
+			}
+		})
+	}
+}
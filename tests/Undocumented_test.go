@@ -0,0 +1,152 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// publishedUndocumentedCycles are the base cycle counts of the undocumented NMOS opcodes
+// as listed in "No More Secrets", the JAM opcodes are left out.
+var publishedUndocumentedCycles = map[c.Instruction]uint{
+	c.SLO_Z: 5, c.SLO_ZX: 6, c.SLO_ABS: 6, c.SLO_ABSX: 7, c.SLO_ABSY: 7, c.SLO_INDX: 8, c.SLO_INDY: 8,
+	c.RLA_Z: 5, c.RLA_ZX: 6, c.RLA_ABS: 6, c.RLA_ABSX: 7, c.RLA_ABSY: 7, c.RLA_INDX: 8, c.RLA_INDY: 8,
+	c.SRE_Z: 5, c.SRE_ZX: 6, c.SRE_ABS: 6, c.SRE_ABSX: 7, c.SRE_ABSY: 7, c.SRE_INDX: 8, c.SRE_INDY: 8,
+	c.RRA_Z: 5, c.RRA_ZX: 6, c.RRA_ABS: 6, c.RRA_ABSX: 7, c.RRA_ABSY: 7, c.RRA_INDX: 8, c.RRA_INDY: 8,
+	c.DCP_Z: 5, c.DCP_ZX: 6, c.DCP_ABS: 6, c.DCP_ABSX: 7, c.DCP_ABSY: 7, c.DCP_INDX: 8, c.DCP_INDY: 8,
+	c.ISC_Z: 5, c.ISC_ZX: 6, c.ISC_ABS: 6, c.ISC_ABSX: 7, c.ISC_ABSY: 7, c.ISC_INDX: 8, c.ISC_INDY: 8,
+	c.SAX_Z: 3, c.SAX_ZY: 4, c.SAX_ABS: 4, c.SAX_INDX: 6,
+	c.LAX_Z: 3, c.LAX_ZY: 4, c.LAX_ABS: 4, c.LAX_ABSY: 4, c.LAX_INDX: 6, c.LAX_INDY: 5,
+	c.ANC_I: 2, c.ANC_2B: 2, c.ALR_I: 2, c.ARR_I: 2, c.SBX_I: 2, c.SBC_EB: 2, c.ANE_I: 2, c.LXA_I: 2,
+	c.SHA_ABSY: 5, c.SHA_INDY: 6, c.SHX_ABSY: 5, c.SHY_ABSX: 5, c.TAS_ABSY: 5, c.LAS_ABSY: 4,
+	c.NOP_1A: 2, c.NOP_3A: 2, c.NOP_5A: 2, c.NOP_7A: 2, c.NOP_DA: 2, c.NOP_FA: 2,
+	c.NOP_80: 2, c.NOP_82: 2, c.NOP_89: 2, c.NOP_C2: 2, c.NOP_E2: 2,
+	c.NOP_04: 3, c.NOP_44: 3, c.NOP_64: 3,
+	c.NOP_14: 4, c.NOP_34: 4, c.NOP_54: 4, c.NOP_74: 4, c.NOP_D4: 4, c.NOP_F4: 4,
+	c.NOP_0C: 4, c.NOP_1C: 4, c.NOP_3C: 4, c.NOP_5C: 4, c.NOP_7C: 4, c.NOP_DC: 4, c.NOP_FC: 4,
+}
+
+var publishedUndocumentedPageCross = map[c.Instruction]bool{
+	c.LAX_ABSY: true, c.LAX_INDY: true, c.LAS_ABSY: true,
+	c.NOP_1C: true, c.NOP_3C: true, c.NOP_5C: true, c.NOP_7C: true, c.NOP_DC: true, c.NOP_FC: true,
+}
+
+func TestUndocumentedOpcodesCoverTheRemainingOpcodes(t *testing.T) {
+	for i := range 256 {
+		official := c.LookupOpcode(c.Instruction(i)).Defined()
+		undocumented := c.LookupUndocumentedOpcode(c.Instruction(i)).Defined()
+		if official == undocumented {
+			t.Errorf("%s has to be either official or undocumented", c.Instruction(i))
+		}
+	}
+}
+
+func TestUndocumentedOpcodesAreIllegalByDefault(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LAX_Z), 0x10)
+	cpu := newStackTestCpu(t)
+
+	_, err := cpu.Step(mem)
+
+	var illegal *c.IllegalOpcodeError
+	if !errors.As(err, &illegal) {
+		t.Fatalf("Expected an IllegalOpcodeError but got %v", err)
+	}
+}
+
+func TestUndocumentedOpcodeCycles(t *testing.T) {
+	for instruction, published := range publishedUndocumentedCycles {
+		op := c.LookupUndocumentedOpcode(instruction)
+		if uint(op.Cycles) != published {
+			t.Errorf("%s: table has %d cycles, published are %d", instruction, op.Cycles, published)
+		}
+		for _, s := range timingSetups {
+			t.Run(instruction.String()+" "+s.name, func(t *testing.T) {
+				expected := published
+				if s.crossed && publishedUndocumentedPageCross[instruction] {
+					expected++
+				}
+				if cycles := measureCycles(t, instruction, s.operand, s.pointer, true); cycles != expected {
+					t.Errorf("Expected %d cycles but took %d", expected, cycles)
+				}
+			})
+		}
+	}
+}
+
+func TestUndocumentedOpcodes(t *testing.T) {
+	data := []struct {
+		name                                                 string
+		program                                              []c.Word
+		a, x, status, memory                                 c.Word
+		expectedA, expectedX, expectedStatus, expectedMemory uint8
+		expectedPC                                           c.Address
+	}{
+		{"LAX", []c.Word{c.Word(c.LAX_Z), 0x10}, 0x00, 0x00, 0, 0x80, 0x80, 0x80, 0b10000000, 0x80, 0x0202},
+		{"SAX", []c.Word{c.Word(c.SAX_Z), 0x10}, 0xF0, 0x3C, 0, 0x00, 0xF0, 0x3C, 0b00000000, 0x30, 0x0202},
+		{"DCP", []c.Word{c.Word(c.DCP_Z), 0x10}, 0x05, 0x00, 0, 0x06, 0x05, 0x00, 0b00000011, 0x05, 0x0202},
+		{"ISC", []c.Word{c.Word(c.ISC_Z), 0x10}, 0x10, 0x00, 0b1, 0x04, 0x0B, 0x00, 0b00000001, 0x05, 0x0202},
+		{"SLO", []c.Word{c.Word(c.SLO_Z), 0x10}, 0x01, 0x00, 0, 0x81, 0x03, 0x00, 0b00000001, 0x02, 0x0202},
+		{"RLA", []c.Word{c.Word(c.RLA_Z), 0x10}, 0xFF, 0x00, 0b1, 0x40, 0x81, 0x00, 0b10000000, 0x81, 0x0202},
+		{"SRE", []c.Word{c.Word(c.SRE_Z), 0x10}, 0x01, 0x00, 0, 0x03, 0x00, 0x00, 0b00000011, 0x01, 0x0202},
+		{"RRA", []c.Word{c.Word(c.RRA_Z), 0x10}, 0x10, 0x00, 0b1, 0x02, 0x91, 0x00, 0b10000000, 0x81, 0x0202},
+		{"ANC", []c.Word{c.Word(c.ANC_I), 0x80}, 0xFF, 0x00, 0, 0x00, 0x80, 0x00, 0b10000001, 0x00, 0x0202},
+		{"ALR", []c.Word{c.Word(c.ALR_I), 0x03}, 0xFF, 0x00, 0, 0x00, 0x01, 0x00, 0b00000001, 0x00, 0x0202},
+		{"ARR", []c.Word{c.Word(c.ARR_I), 0xC0}, 0xFF, 0x00, 0, 0x00, 0x60, 0x00, 0b00000001, 0x00, 0x0202},
+		{"ARR with carry", []c.Word{c.Word(c.ARR_I), 0x80}, 0xFF, 0x00, 0b1, 0x00, 0xC0, 0x00, 0b11000001, 0x00, 0x0202},
+		{"SBX", []c.Word{c.Word(c.SBX_I), 0x05}, 0xFF, 0x0F, 0, 0x00, 0xFF, 0x0A, 0b00000001, 0x00, 0x0202},
+		{"SBC_EB", []c.Word{c.Word(c.SBC_EB), 0x01}, 0x10, 0x00, 0b1, 0x00, 0x0F, 0x00, 0b00000001, 0x00, 0x0202},
+		{"NOP_0C", []c.Word{c.Word(c.NOP_0C), 0x10, 0x00}, 0x01, 0x02, 0b1, 0x00, 0x01, 0x02, 0b00000001, 0x00, 0x0203},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			assert := ut.AssertHelperNew(t)
+			mem := ut.NewProgramMemory(t, 0x0200, d.program...)
+			mem.WriteWord(0x0010, d.memory)
+			cpu := newStackTestCpu(t)
+			cpu.UndocumentedOpcodes = true
+			cpu.Accumulator = d.a
+			cpu.RegisterX = d.x
+			cpu.Status.Status = d.status
+
+			if _, err := cpu.Step(mem); err != nil {
+				t.Fatal(err)
+			}
+			assert.AssertEqualsUint8(d.expectedA, cpu.Accumulator, "Wrong accumulator %v")
+			assert.AssertEqualsUint8(d.expectedX, cpu.RegisterX, "Wrong X register %v")
+			assert.AssertEqualsUint8(d.expectedStatus, cpu.Status, "Wrong status \n%v")
+			assert.AssertEqualsUint8(d.expectedMemory, mem.ReadWord(0x0010), "Wrong memory %v")
+			assertProgramCounter(t, cpu, d.expectedPC)
+		})
+	}
+}
+
+func TestJamHaltsUntilReset(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.JAM_02), c.Word(c.LDX_I), 0x01)
+	mem.WriteAddress(c.ResetVector, 0x0201)
+	cpu := newStackTestCpu(t)
+	cpu.UndocumentedOpcodes = true
+
+	_, err := cpu.Step(mem)
+	var jam *c.JamError
+	if !errors.As(err, &jam) || jam.ProgramCounter != 0x0200 || jam.Opcode != c.JAM_02 {
+		t.Fatalf("Expected a JamError at 0x0200 but got %v", err)
+	}
+
+	cpu.TriggerNMI()
+	cycles, err := cpu.Step(mem)
+	if !errors.As(err, &jam) || cycles != 0 {
+		t.Fatalf("A jammed CPU must not run, took %d cycles and returned %v", cycles, err)
+	}
+	assertProgramCounter(t, cpu, 0x0200)
+
+	cpu.Reset(mem)
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	if cpu.RegisterX != 0x01 {
+		t.Fatalf("The CPU has to run again after a reset")
+	}
+}
@@ -0,0 +1,523 @@
+package tests_test
+
+import (
+	"errors"
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+// native switches the 65816 from emulation to native mode
+var native = []c.Word{c.Word(c.CLC), c.Word(c.XCE)}
+
+// newW65816TestCpu loads the program at $8000 in bank 0 and runs the reset sequence,
+// the CPU starts in emulation mode.
+func newW65816TestCpu(t *testing.T, program ...c.Word) (*c.SixFiveEightSixteen, *c.Memory16M) {
+	t.Helper()
+	mem := &c.Memory16M{}
+	if err := mem.Init(); err != nil {
+		t.Fatal(err)
+	}
+	for i, w := range program {
+		mem.WriteLong(c.LongAddress(0x8000+i), w)
+	}
+	mem.WriteLong(c.LongAddress(c.ResetVector), 0x00)
+	mem.WriteLong(c.LongAddress(c.ResetVector+1), 0x80)
+	cpu := c.NewSixFiveEightSixteen(ut.NewTestCpuLogger(t))
+	cpu.PowerOn(mem)
+	return cpu, mem
+}
+
+// runInstructions runs the given number of instructions and returns the cycles they took.
+func runInstructions(t *testing.T, cpu *c.SixFiveEightSixteen, mem c.Memory24, instructions int) uint {
+	t.Helper()
+	start := cpu.Cycle
+	for range instructions {
+		if _, err := cpu.Step(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cpu.Cycle - start
+}
+
+func assertRegister16(t *testing.T, name string, expected, actual uint16) {
+	t.Helper()
+	if expected != actual {
+		t.Fatalf("Expected %s %#04x but got %#04x", name, expected, actual)
+	}
+}
+
+func TestW65816ResetStartsInEmulationMode(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	cpu, mem := newW65816TestCpu(t, c.Word(c.LDA_I), 0x80, c.Word(c.PHA))
+
+	if !cpu.Emulation {
+		t.Fatal("Expected the CPU to start in emulation mode")
+	}
+	assert.AssertEqualsUint8(0b00110100, cpu.Status, "M, X and I have to be set after a reset \n%v")
+	assertRegister16(t, "StackPointer", 0x01FD, cpu.StackPointer)
+	if cpu.ProgramCounter != 0x8000 {
+		t.Fatalf("Expected PC 0x8000 but got %v", cpu.ProgramCounter)
+	}
+
+	runInstructions(t, cpu, mem, 2)
+	assert.AssertEqualsUint8(0x80, mem.ReadLong(0x0001FD), "Wrong value on the stack %v")
+	assertRegister16(t, "StackPointer", 0x01FC, cpu.StackPointer)
+}
+
+func TestW65816RegisterWidths(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.REP), 0x30,
+		c.Word(c.LDA_I), 0x34, 0x12,
+		c.Word(c.LDX_I), 0xCD, 0xAB,
+		c.Word(c.SEP), 0x30,
+		c.Word(c.LDA_I), 0x56,
+		c.Word(c.XBA),
+	)...)
+
+	runInstructions(t, cpu, mem, 3)
+	if cpu.Emulation {
+		t.Fatal("XCE has to switch to native mode")
+	}
+	assert.AssertEqualsUint8(1, cpu.Status.GetCarryFlag(), "XCE has to move the emulation flag into the carry %v")
+
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 3 {
+		t.Fatalf("A 16 bit LDA immediate takes 3 cycles but took %d", cycles)
+	}
+	assertRegister16(t, "Accumulator", 0x1234, cpu.Accumulator)
+	runInstructions(t, cpu, mem, 1)
+	assertRegister16(t, "X", 0xABCD, cpu.RegisterX)
+	assert.AssertEqualsUint8(1, cpu.Status.GetNegativeFlag(), "Bit 15 has to set the negative flag %v")
+
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "X", 0x00CD, cpu.RegisterX)
+	assertRegister16(t, "Accumulator", 0x1256, cpu.Accumulator)
+
+	runInstructions(t, cpu, mem, 1)
+	assertRegister16(t, "Accumulator", 0x5612, cpu.Accumulator)
+	assert.AssertEqualsUint8(0, cpu.Status.GetNegativeFlag(), "XBA has to set the flags from the new A %v")
+}
+
+func TestW65816EmulationModeForcesEightBitRegisters(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, c.Word(c.REP), 0x30, c.Word(c.LDA_I), 0x34, c.Word(c.LDX_I), 0x12)
+
+	runInstructions(t, cpu, mem, 3)
+	assertRegister16(t, "Accumulator", 0x0034, cpu.Accumulator)
+	assertRegister16(t, "X", 0x0012, cpu.RegisterX)
+	if cpu.ProgramCounter != 0x8006 {
+		t.Fatalf("The immediate operands have to be 8 bit, PC is %v", cpu.ProgramCounter)
+	}
+}
+
+func TestW65816LongAddressing(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.LDA_ABSL), 0x56, 0x34, 0x12,
+		c.Word(c.LDX_I), 0x02,
+		c.Word(c.STA_ABSLX), 0x10, 0x00, 0x7E,
+		c.Word(c.LDY_I), 0x01,
+		c.Word(c.LDA_ZINDLY), 0x20,
+	)...)
+	mem.WriteLong(0x123456, 0x42)
+	mem.WriteLong(0x000020, 0xFF)
+	mem.WriteLong(0x000021, 0xFF)
+	mem.WriteLong(0x000022, 0x05)
+	mem.WriteLong(0x060000, 0x99)
+
+	runInstructions(t, cpu, mem, 2)
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 5 {
+		t.Fatalf("LDA long takes 5 cycles but took %d", cycles)
+	}
+	assertRegister16(t, "Accumulator", 0x0042, cpu.Accumulator)
+
+	runInstructions(t, cpu, mem, 2)
+	assert.AssertEqualsUint8(0x42, mem.ReadLong(0x7E0012), "Expected the value in bank $7E but got %v")
+
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "Accumulator", 0x0099, cpu.Accumulator)
+}
+
+func TestW65816DirectPageAndDataBank(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.REP), 0x20,
+		c.Word(c.LDA_I), 0x00, 0x10,
+		c.Word(c.TCD),
+		c.Word(c.SEP), 0x20,
+		c.Word(c.LDA_Z), 0x10,
+		c.Word(c.LDA_I), 0x7E,
+		c.Word(c.PHA),
+		c.Word(c.PLB),
+		c.Word(c.LDA_ABS), 0x00, 0x20,
+	)...)
+	mem.WriteLong(0x001010, 0x11)
+	mem.WriteLong(0x001011, 0x22)
+	mem.WriteLong(0x7E2000, 0x33)
+
+	runInstructions(t, cpu, mem, 6)
+	assertRegister16(t, "DirectPage", 0x1000, cpu.DirectPage)
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 3 {
+		t.Fatalf("LDA direct takes 3 cycles but took %d", cycles)
+	}
+	assertRegister16(t, "Accumulator", 0x1011, cpu.Accumulator)
+
+	runInstructions(t, cpu, mem, 4)
+	if cpu.DataBank != 0x7E {
+		t.Fatalf("Expected DataBank 0x7E but got %v", cpu.DataBank)
+	}
+	assertRegister16(t, "Accumulator", 0x1033, cpu.Accumulator)
+
+	// A direct page that is not page aligned costs an extra cycle
+	cpu.DirectPage = 0x1001
+	cpu.ProgramCounter = 0x800A
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 4 {
+		t.Fatalf("LDA direct with an unaligned direct page takes 4 cycles but took %d", cycles)
+	}
+	assertRegister16(t, "Accumulator", 0x1022, cpu.Accumulator)
+}
+
+func TestW65816BlockMove(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.REP), 0x30,
+		c.Word(c.LDA_I), 0x02, 0x00,
+		c.Word(c.LDX_I), 0x00, 0x10,
+		c.Word(c.LDY_I), 0x00, 0x20,
+		c.Word(c.MVN), 0x02, 0x01,
+		c.Word(c.NOP),
+	)...)
+	for i, w := range []c.Word{0xAA, 0xBB, 0xCC, 0xDD} {
+		mem.WriteLong(c.LongAddress(0x011000+i), w)
+	}
+
+	runInstructions(t, cpu, mem, 6)
+	if cycles := runInstructions(t, cpu, mem, 3); cycles != 21 {
+		t.Fatalf("MVN takes 7 cycles per byte but took %d for 3 bytes", cycles)
+	}
+	for i, w := range []c.Word{0xAA, 0xBB, 0xCC, 0x00} {
+		if actual := mem.ReadLong(c.LongAddress(0x022000 + i)); actual != w {
+			t.Fatalf("Expected %v at %#06x but got %v", w, 0x022000+i, actual)
+		}
+	}
+	assertRegister16(t, "Accumulator", 0xFFFF, cpu.Accumulator)
+	assertRegister16(t, "X", 0x1003, cpu.RegisterX)
+	assertRegister16(t, "Y", 0x2003, cpu.RegisterY)
+	if cpu.DataBank != 0x02 || cpu.ProgramCounter != 0x8010 {
+		t.Fatalf("Expected DataBank 0x02 and PC 0x8010 but got %v and %v", cpu.DataBank, cpu.ProgramCounter)
+	}
+}
+
+func TestW65816PushEffectiveAddress(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.PEA), 0x34, 0x12,
+		c.Word(c.PEI), 0x10,
+		c.Word(c.PER), 0x10, 0x00,
+		c.Word(c.PHK),
+	)...)
+	mem.WriteLong(0x000010, 0x78)
+	mem.WriteLong(0x000011, 0x56)
+
+	runInstructions(t, cpu, mem, 2)
+	if cycles := runInstructions(t, cpu, mem, 4); cycles != 5+6+6+3 {
+		t.Fatalf("Expected %d cycles but took %d", 5+6+6+3, cycles)
+	}
+	// PER pushes the address following it plus the offset, $800A + $10
+	expected := []c.Word{0x12, 0x34, 0x56, 0x78, 0x80, 0x1A, 0x00}
+	for i, w := range expected {
+		if actual := mem.ReadLong(c.LongAddress(0x01FD - i)); actual != w {
+			t.Fatalf("Expected %v at %#04x but got %v", w, 0x01FD-i, actual)
+		}
+	}
+}
+
+func TestW65816JumpToSubroutineLong(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.JSL_ABSL), 0x00, 0x90, 0x01,
+		c.Word(c.NOP),
+	)...)
+	mem.WriteLong(0x019000, c.Word(c.RTL))
+
+	runInstructions(t, cpu, mem, 2)
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 8 {
+		t.Fatalf("JSL takes 8 cycles but took %d", cycles)
+	}
+	if cpu.ProgramBank != 0x01 || cpu.ProgramCounter != 0x9000 {
+		t.Fatalf("Expected to continue at $019000 but got %v", c.NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+	}
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 6 {
+		t.Fatalf("RTL takes 6 cycles but took %d", cycles)
+	}
+	if cpu.ProgramBank != 0x00 || cpu.ProgramCounter != 0x8006 {
+		t.Fatalf("Expected to return to $008006 but got %v", c.NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+	}
+}
+
+func TestW65816DecimalMode16Bit(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.REP), 0x20,
+		c.Word(c.SED),
+		c.Word(c.CLC),
+		c.Word(c.LDA_I), 0x99, 0x19,
+		c.Word(c.ADC_I), 0x01, 0x00,
+		c.Word(c.SEC),
+		c.Word(c.SBC_I), 0x01, 0x00,
+	)...)
+
+	runInstructions(t, cpu, mem, 7)
+	assertRegister16(t, "Accumulator", 0x2000, cpu.Accumulator)
+	assert.AssertEqualsUint8(0, cpu.Status.GetCarryFlag(), "Wrong carry %v")
+
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "Accumulator", 0x1999, cpu.Accumulator)
+	assert.AssertEqualsUint8(1, cpu.Status.GetCarryFlag(), "SBC without borrow has to set the carry %v")
+}
+
+func TestW65816NativeInterrupt(t *testing.T) {
+	assert := ut.AssertHelperNew(t)
+	cpu, mem := newW65816TestCpu(t, append(native,
+		c.Word(c.CLI),
+		c.Word(c.JML_ABSL), 0x00, 0x90, 0x01,
+	)...)
+	mem.WriteLong(c.LongAddress(c.NativeIRQVector), 0x00)
+	mem.WriteLong(c.LongAddress(c.NativeIRQVector+1), 0xA0)
+	mem.WriteLong(0x00A000, c.Word(c.RTI))
+	mem.WriteLong(0x019000, c.Word(c.NOP))
+
+	runInstructions(t, cpu, mem, 4)
+	cpu.AssertIRQ()
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 8 {
+		t.Fatalf("A native IRQ takes 8 cycles but took %d", cycles)
+	}
+	cpu.ReleaseIRQ()
+	if cpu.ProgramBank != 0x00 || cpu.ProgramCounter != 0xA000 {
+		t.Fatalf("Expected the native IRQ vector but got %v", c.NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+	}
+	assert.AssertEqualsUint8(0x01, mem.ReadLong(0x0001FD), "The ProgramBank has to be pushed first, got %v")
+
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 7 {
+		t.Fatalf("A native RTI takes 7 cycles but took %d", cycles)
+	}
+	if cpu.ProgramBank != 0x01 || cpu.ProgramCounter != 0x9000 {
+		t.Fatalf("Expected to return to $019000 but got %v", c.NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+	}
+}
+
+// TestW65816MeasuredCyclesMatchTable executes every opcode once in emulation mode, where the
+// registers are 8 bit and the cycles have to match the base cycles of the opcode table.
+func TestW65816MeasuredCyclesMatchTable(t *testing.T) {
+	for i := range 256 {
+		instruction := c.Instruction(i)
+		op := c.LookupW65816Opcode(instruction)
+		if !op.Defined() {
+			t.Errorf("%#02x is missing from the 65816 opcode table", i)
+			continue
+		}
+		if op.Mode == c.Relative && op.Mnemonic != "BRA" {
+			continue
+		}
+		t.Run(op.Mnemonic+" "+op.Mode.String(), func(t *testing.T) {
+			mem := c.Memory24From(ut.NewProgramMemory(t, 0x8000, c.Word(instruction), 0x10, 0x20, 0x00))
+			mem.WriteLong(c.LongAddress(c.ResetVector+1), 0x80)
+			cpu := c.NewSixFiveEightSixteen(ut.NewTestCpuLogger(t))
+			cpu.VerifyCycles = true
+			cpu.PowerOn(mem)
+
+			cycles, err := cpu.Step(mem)
+			var jam *c.JamError
+			if err != nil && !errors.As(err, &jam) {
+				t.Fatal(err)
+			}
+			if cycles != uint(op.Cycles) {
+				t.Errorf("Expected %d cycles but took %d", op.Cycles, cycles)
+			}
+		})
+	}
+}
+
+// countingMemory24 counts every access of the 65816 to the bus.
+type countingMemory24 struct {
+	*c.Memory16M
+	accesses uint
+}
+
+func (mem *countingMemory24) ReadLong(source c.LongAddress) c.Word {
+	mem.accesses++
+	return mem.Memory16M.ReadLong(source)
+}
+
+func (mem *countingMemory24) WriteLong(destination c.LongAddress, value c.Word) {
+	mem.accesses++
+	mem.Memory16M.WriteLong(destination, value)
+}
+
+// w65816TickProgram runs on the core in emulation mode, then reads and writes other banks with 16 bit registers.
+var w65816TickProgram = []c.Word{
+	c.Word(c.LDA_I), 0x41,
+	c.Word(c.INC_Z), 0x10,
+	c.Word(c.PHA),
+	c.Word(c.CLC), c.Word(c.XCE),
+	c.Word(c.REP), 0x30,
+	c.Word(c.LDA_ABSL), 0x00, 0x00, 0x01,
+	c.Word(c.STA_ABSLX), 0x10, 0x00, 0x7E,
+	c.Word(c.INC_ABS), 0x20, 0x00,
+	c.Word(c.JSL_ABSL), 0x00, 0x90, 0x00,
+}
+
+func TestW65816EveryTickIsOneBusAccess(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, w65816TickProgram...)
+	counting := &countingMemory24{Memory16M: mem}
+	mem.WriteLong(0x010000, 0x34)
+	mem.WriteLong(0x010001, 0x12)
+
+	for range 60 {
+		cycle, accesses := cpu.Cycle, counting.accesses
+		if err := cpu.Tick(counting); err != nil {
+			t.Fatal(err)
+		}
+		if cpu.Cycle != cycle+1 || counting.accesses != accesses+1 {
+			t.Fatalf("Expected one cycle and one access but got %d cycles and %d accesses at %s",
+				cpu.Cycle-cycle, counting.accesses-accesses, c.NewLongAddress(cpu.ProgramBank, cpu.ProgramCounter))
+		}
+	}
+	cpu.Stop()
+	assertRegister16(t, "Accumulator", 0x1234, cpu.Accumulator)
+	if actual := mem.ReadLong(0x7E0010); actual != 0x34 {
+		t.Fatalf("Expected STA long to write 0x34 but got %v", actual)
+	}
+}
+
+func TestW65816TickMatchesStep(t *testing.T) {
+	stepped, stepMem := newW65816TestCpu(t, w65816TickProgram...)
+	ticked, tickMem := newW65816TestCpu(t, w65816TickProgram...)
+
+	for range 10 {
+		if _, err := stepped.Step(stepMem); err != nil {
+			t.Fatal(err)
+		}
+		for ticked.Cycle < stepped.Cycle {
+			if err := ticked.Tick(tickMem); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if ticked.ProgramCounter != stepped.ProgramCounter || ticked.Accumulator != stepped.Accumulator ||
+			ticked.StackPointer != stepped.StackPointer || ticked.Status != stepped.Status {
+			t.Fatalf("Tick and Step differ after %d cycles:\n%s\n%s", stepped.Cycle, ticked, stepped)
+		}
+	}
+	ticked.Stop()
+}
+
+func TestW65816WatchSeesEveryMode(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, w65816TickProgram...)
+	mem.WriteLong(0x010000, 0x34)
+	mem.WriteLong(0x010001, 0x12)
+	var events []c.WatchEvent
+	cpu.Watch(c.WatchWrite, 0x0010, 0x0011, func(event c.WatchEvent) {
+		events = append(events, event)
+	})
+
+	runInstructions(t, cpu, mem, 8)
+	expected := []c.WatchEvent{
+		// INC on the core writes the unmodified value back before the result
+		{Kind: c.WatchWrite, Address: 0x0010, Value: 0x00, Cycle: 13, ProgramCounter: 0x8002},
+		{Kind: c.WatchWrite, Address: 0x0010, Value: 0x01, Cycle: 14, ProgramCounter: 0x8002},
+		// STA long writes to bank $7E, the watch sees the address inside the bank
+		{Kind: c.WatchWrite, Address: 0x0010, Value: 0x34, Cycle: 35, ProgramCounter: 0x800D},
+		{Kind: c.WatchWrite, Address: 0x0011, Value: 0x12, Cycle: 36, ProgramCounter: 0x800D},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Expected %d events but got %v", len(expected), events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("Expected %s but got %s", expected[i], events[i])
+		}
+	}
+}
+
+func TestW65816VerifyCyclesInEmulationMode(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t,
+		c.Word(c.LDA_I), 0x10,
+		c.Word(c.TCD),
+		c.Word(c.PEI), 0x02,
+		c.Word(c.LDA_ZINDL), 0x04,
+		c.Word(c.SED),
+		c.Word(c.ADC_I), 0x01,
+		c.Word(c.BNE), 0x00,
+	)
+	cpu.VerifyCycles = true
+
+	// The direct page at $0010 is not page aligned, PEI and LDA [$04] take a cycle more than the table
+	if cycles := runInstructions(t, cpu, mem, 4); cycles != 2+2+7+7 {
+		t.Fatalf("Expected %d cycles but took %d", 2+2+7+7, cycles)
+	}
+	// Unlike the 65C02 the 65816 does not spend a cycle on the flags of a decimal ADC
+	if cycles := runInstructions(t, cpu, mem, 3); cycles != 2+2+3 {
+		t.Fatalf("Expected %d cycles but took %d", 2+2+3, cycles)
+	}
+}
+
+// TestW65816EmulationModeUsesBankRegisters runs the instructions the 65816 shares with the 65C02 in emulation
+// mode, they fetch from the ProgramBank and address the DataBank and the DirectPage.
+func TestW65816EmulationModeUsesBankRegisters(t *testing.T) {
+	cpu, mem := newW65816TestCpu(t, c.Word(c.JML_ABSL), 0x00, 0x80, 0x01)
+	for i, w := range []c.Word{
+		c.Word(c.LDA_I), 0x42,
+		c.Word(c.LDA_I), 0x01, c.Word(c.PHA), c.Word(c.PLB),
+		c.Word(c.LDA_ABS), 0x00, 0x10,
+		c.Word(c.LDX_I), 0x01, c.Word(c.LDA_ABSX), 0xFF, 0xFF,
+		c.Word(c.LDA_I), 0x12, c.Word(c.XBA), c.Word(c.LDA_I), 0x00, c.Word(c.TCD),
+		c.Word(c.LDX_I), 0x20, c.Word(c.LDA_ZX), 0xF0,
+		c.Word(c.LDA_I), 0x30, c.Word(c.TCD),
+		c.Word(c.LDA_Z), 0x10,
+		c.Word(c.LDA_ZX), 0xF0,
+	} {
+		mem.WriteLong(c.LongAddress(0x018000+i), w)
+	}
+	mem.WriteLong(0x001000, 0x99)
+	mem.WriteLong(0x011000, 0x55)
+	mem.WriteLong(0x020000, 0x66)
+	mem.WriteLong(0x001210, 0x77)
+	mem.WriteLong(0x001240, 0x44)
+	mem.WriteLong(0x001340, 0x88)
+	cpu.VerifyCycles = true
+
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "Accumulator", 0x0042, cpu.Accumulator)
+	runInstructions(t, cpu, mem, 4)
+	if cpu.DataBank != 0x01 {
+		t.Fatalf("Expected DataBank 0x01 but got %v", cpu.DataBank)
+	}
+	assertRegister16(t, "Accumulator", 0x0055, cpu.Accumulator)
+	// Indexing carries into the next bank
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "Accumulator", 0x0066, cpu.Accumulator)
+
+	// A page aligned DirectPage wraps around inside its page like the zero page
+	runInstructions(t, cpu, mem, 4)
+	assertRegister16(t, "DirectPage", 0x1200, cpu.DirectPage)
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "Accumulator", 0x1277, cpu.Accumulator)
+
+	// A DirectPage that is not page aligned does not wrap around and costs an extra cycle
+	runInstructions(t, cpu, mem, 2)
+	assertRegister16(t, "DirectPage", 0x1230, cpu.DirectPage)
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 4 || cpu.Accumulator != 0x1244 {
+		t.Fatalf("Expected LDA $10 to read $1240 in 4 cycles but took %d\n%s", cycles, cpu)
+	}
+	if cycles := runInstructions(t, cpu, mem, 1); cycles != 5 || cpu.Accumulator != 0x1288 {
+		t.Fatalf("Expected LDA $F0,X to read $1340 in 5 cycles but took %d\n%s", cycles, cpu)
+	}
+	if cpu.ProgramBank != 0x01 {
+		t.Fatalf("Expected to run in bank 1 but got %v", cpu.ProgramBank)
+	}
+}
+
+func TestMemory24FromMirrorsBanks(t *testing.T) {
+	mem := c.Memory24From(ut.NewProgramMemory(t, 0x0200))
+	mem.WriteLong(0x7E1234, 0x42)
+	if actual := mem.ReadLong(0x001234); actual != 0x42 {
+		t.Fatalf("Expected every bank to map to the same memory but got %v", actual)
+	}
+}
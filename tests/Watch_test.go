@@ -0,0 +1,110 @@
+package tests_test
+
+import (
+	"testing"
+
+	c "noah-ruben.com/6502/computer"
+	ut "noah-ruben.com/6502/tests/util"
+)
+
+func TestWatchReportsWrites(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200,
+		c.Word(c.LDA_I), 0x41,
+		c.Word(c.STA_ABS), 0x00, 0x60,
+		c.Word(c.INC_ABS), 0x00, 0x60,
+	)
+	cpu := newStackTestCpu(t)
+	var events []c.WatchEvent
+	cpu.Watch(c.WatchWrite, 0x6000, 0x600F, func(event c.WatchEvent) {
+		events = append(events, event)
+	})
+
+	if err := cpu.Execute(12, mem, false); err != nil {
+		t.Fatal(err)
+	}
+	expected := []c.WatchEvent{
+		{Kind: c.WatchWrite, Address: 0x6000, Value: 0x41, Cycle: 6, ProgramCounter: 0x0202},
+		// INC writes the unmodified value back before the result
+		{Kind: c.WatchWrite, Address: 0x6000, Value: 0x41, Cycle: 11, ProgramCounter: 0x0205},
+		{Kind: c.WatchWrite, Address: 0x6000, Value: 0x42, Cycle: 12, ProgramCounter: 0x0205},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Expected %d events but got %v", len(expected), events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("Expected %s but got %s", expected[i], events[i])
+		}
+	}
+}
+
+func TestWatchDetectsSelfModifyingCode(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200,
+		c.Word(c.LDA_I), c.Word(c.INX),
+		c.Word(c.STA_ABS), 0x05, 0x02,
+		c.Word(c.NOP),
+	)
+	cpu := newStackTestCpu(t)
+	written := map[c.Address]bool{}
+	var modified []c.Address
+	cpu.Watch(c.WatchWrite, 0x0200, 0x02FF, func(event c.WatchEvent) {
+		written[event.Address] = true
+	})
+	cpu.Watch(c.WatchExecute, 0x0200, 0x02FF, func(event c.WatchEvent) {
+		if written[event.Address] {
+			modified = append(modified, event.Address)
+		}
+	})
+
+	for range 3 {
+		if _, err := cpu.Step(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(modified) != 1 || modified[0] != 0x0205 || cpu.RegisterX != 0x01 {
+		t.Fatalf("Expected the modified INX at 0x0205 to be executed but got %v", modified)
+	}
+}
+
+func TestWatchCanBeRemoved(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDA_Z), 0x10, c.Word(c.LDA_Z), 0x10)
+	cpu := newStackTestCpu(t)
+	reads := 0
+	remove := cpu.Watch(c.WatchRead|c.WatchWrite, 0x0010, 0x0010, func(event c.WatchEvent) {
+		reads++
+	})
+
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	remove()
+	if _, err := cpu.Step(mem); err != nil {
+		t.Fatal(err)
+	}
+	if reads != 1 {
+		t.Fatalf("Expected a single read before the watch was removed but got %d", reads)
+	}
+}
+
+func TestWatchCanRemoveItself(t *testing.T) {
+	mem := ut.NewProgramMemory(t, 0x0200, c.Word(c.LDA_Z), 0x10, c.Word(c.LDA_Z), 0x10)
+	cpu := newStackTestCpu(t)
+	once, every := 0, 0
+	var remove func()
+	remove = cpu.Watch(c.WatchRead, 0x0010, 0x0010, func(event c.WatchEvent) {
+		once++
+		remove()
+	})
+	cpu.Watch(c.WatchRead, 0x0010, 0x0010, func(event c.WatchEvent) {
+		every++
+	})
+
+	for range 2 {
+		if _, err := cpu.Step(mem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if once != 1 || every != 2 {
+		t.Fatalf("Expected the removed watch to fire once and the other twice but got %d and %d", once, every)
+	}
+}
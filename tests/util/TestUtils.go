@@ -99,12 +99,36 @@ func (mem *TestMemory) ReadAddress(source c.Address) c.Address {
 	msb := mem.ReadWord(source + 1)
 	return c.Address(uint16(msb)<<8 | uint16(lsb))
 }
+
+// Peek returns the value the next read will return without consuming it, the address is ignored.
+func (mem *TestMemory) Peek(_ c.Address) c.Word {
+	if mem.idx >= uint(len(mem.Data)) {
+		return 0
+	}
+	return mem.Data[mem.idx]
+}
+func (mem *TestMemory) Poke(destination c.Address, value c.Word) {
+	mem.t.Log("[WARN] DONT USE `Poke(<args>)` it does nothing!")
+}
 func (mem *TestMemory) AppendInplace(data []c.Word) {
 	for _, word := range data {
 		mem.Data = append(mem.Data, word)
 	}
 }
 
+// NewProgramMemory returns an initialized Memory16K with the program copied to the given address.
+func NewProgramMemory(tee *testing.T, address c.Address, program ...c.Word) *c.Memory16K {
+	tee.Helper()
+	mem := &c.Memory16K{}
+	if err := mem.Init(); err != nil {
+		tee.Fatalf("Failed to initialize memory: %v", err)
+	}
+	for idx, word := range program {
+		mem.WriteWord(address+c.Address(idx), word)
+	}
+	return mem
+}
+
 // InstructionTestData can be used to create a Test from this "configuration"
 type InstructionTestData struct {
 	// Name - Name of  the Test
@@ -116,7 +140,9 @@ type InstructionTestData struct {
 	RegisterXSetup c.Word
 	// RegisterYSetup - Value that should be in the Y-Register before the computer executes the first Instruction
 	RegisterYSetup c.Word
-	// MemorySetup - Continous memory values. These will be appended to the TestMemory and will be "returned" FIFO as requests are made to the memory. This means memory jump operations are not exexuted. Just the next values are returned!
+	// ProcessorStatusSetup - Value of the [../../computer/status.go|ProcessorStatus] before the computer executes the first Instruction
+	ProcessorStatusSetup c.Word
+	// MemorySetup - Continous memory values. These will be appended to the TestMemory and will be "returned" FIFO as requests are made to the memory. This means memory jump operations are not exexuted. Just the next values are returned! Dummy reads of the CPU, like the byte after an implied instruction, take a value as well.
 	MemorySetup []c.Word
 
 	// ExpectToAdvancedCycles - The number of cycles that the compputer should have advanced
@@ -133,6 +159,7 @@ func (i InstructionTestData) Run(tee *testing.T, cpu *c.SixFiveOTwo, tm *TestMem
 		currentCycles := cpu.Cycle
 		// Setup
 		cpu.Status.Reset()
+		cpu.Status.Status = i.ProcessorStatusSetup
 		cpu.Accumulator = i.AccumolatorSetup
 		cpu.RegisterX = i.RegisterXSetup
 		cpu.RegisterY = i.RegisterYSetup
@@ -140,10 +167,14 @@ func (i InstructionTestData) Run(tee *testing.T, cpu *c.SixFiveOTwo, tm *TestMem
 		tm.AppendInplace(i.MemorySetup)
 
 		// Test
-		cpu.Execute(1, tm, true)
+		if err := cpu.Execute(1, tm, true); err != nil {
+			t.Fatal(err)
+		}
 		assert.AssertEqualsUint8(i.ExpectAccumulatorValue, cpu.Accumulator, "Wrong Accumulator state expected: %#02x but got: %v", i.ExpectAccumulatorValue)
 		assert.AssertEqualsUint8(i.ExpectedProcessorStatusValue, cpu.Status, "Wrong Status register expected:\n        %#08b but got: \n%v", i.ExpectedProcessorStatusValue)
-		cpu.AssertCycle(currentCycles + i.ExpectToAdvancedCycles)
+		if err := cpu.AssertCycle(currentCycles + i.ExpectToAdvancedCycles); err != nil {
+			t.Fatal(err)
+		}
 	})
 
 }